@@ -0,0 +1,172 @@
+package goshopify
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const abandonedCheckoutsBasePath = "checkouts"
+
+// AbandonedCheckoutService is an interface for interfacing with the abandoned
+// checkout endpoints of the Shopify API.
+// See: https://shopify.dev/docs/api/admin-rest/2023-01/resources/abandoned-checkouts
+type AbandonedCheckoutService interface {
+	List(interface{}) ([]AbandonedCheckout, error)
+	ListWithPagination(interface{}) ([]AbandonedCheckout, *Pagination, error)
+	Count(interface{}) (int, error)
+	Recover(AbandonedCheckout, *AppliedDiscount) (*DraftOrder, error)
+}
+
+// AbandonedCheckoutServiceOp handles communication with the abandoned checkout
+// related methods of the Shopify API.
+type AbandonedCheckoutServiceOp struct {
+	client *Client
+}
+
+// AbandonedCheckout represents a checkout the customer left without
+// completing the purchase
+type AbandonedCheckout struct {
+	ID                    int64            `json:"id,omitempty" bson:"id,omitempty"`
+	Token                 string           `json:"token,omitempty" bson:"token,omitempty"`
+	CartToken             string           `json:"cart_token,omitempty" bson:"cart_token,omitempty"`
+	Name                  string           `json:"name,omitempty" bson:"name,omitempty"`
+	Email                 string           `json:"email,omitempty" bson:"email,omitempty"`
+	Phone                 string           `json:"phone,omitempty" bson:"phone,omitempty"`
+	Gateway               string           `json:"gateway,omitempty" bson:"gateway,omitempty"`
+	BuyerAcceptsMarketing bool             `json:"buyer_accepts_marketing,omitempty" bson:"buyer_accepts_marketing,omitempty"`
+	AbandonedCheckoutURL  string           `json:"abandoned_checkout_url,omitempty" bson:"abandoned_checkout_url,omitempty"`
+	LandingSite           string           `json:"landing_site,omitempty" bson:"landing_site,omitempty"`
+	ReferringSite         string           `json:"referring_site,omitempty" bson:"referring_site,omitempty"`
+	SourceName            string           `json:"source_name,omitempty" bson:"source_name,omitempty"`
+	CustomerLocale        string           `json:"customer_locale,omitempty" bson:"customer_locale,omitempty"`
+	Note                  string           `json:"note,omitempty" bson:"note,omitempty"`
+	NoteAttributes        []NoteAttribute  `json:"note_attributes,omitempty" bson:"note_attributes,omitempty"`
+	Currency              string           `json:"currency,omitempty" bson:"currency,omitempty"`
+	PresentmentCurrency   string           `json:"presentment_currency,omitempty" bson:"presentment_currency,omitempty"`
+	TaxesIncluded         bool             `json:"taxes_included,omitempty" bson:"taxes_included,omitempty"`
+	TotalWeight           int              `json:"total_weight,omitempty" bson:"total_weight,omitempty"`
+	TotalDiscounts        *decimal.Decimal `json:"total_discounts,omitempty" bson:"total_discounts,omitempty"`
+	TotalLineItemsPrice   *decimal.Decimal `json:"total_line_items_price,omitempty" bson:"total_line_items_price,omitempty"`
+	SubtotalPrice         *decimal.Decimal `json:"subtotal_price,omitempty" bson:"subtotal_price,omitempty"`
+	TotalTax              *decimal.Decimal `json:"total_tax,omitempty" bson:"total_tax,omitempty"`
+	TotalPrice            *decimal.Decimal `json:"total_price,omitempty" bson:"total_price,omitempty"`
+	LineItems             []LineItem       `json:"line_items,omitempty" bson:"line_items,omitempty"`
+	ShippingLines         []ShippingLines  `json:"shipping_lines,omitempty" bson:"shipping_lines,omitempty"`
+	TaxLines              []TaxLine        `json:"tax_lines,omitempty" bson:"tax_lines,omitempty"`
+	DiscountCodes         []DiscountCode   `json:"discount_codes,omitempty" bson:"discount_codes,omitempty"`
+	BillingAddress        *Address         `json:"billing_address,omitempty" bson:"billing_address,omitempty"`
+	ShippingAddress       *Address         `json:"shipping_address,omitempty" bson:"shipping_address,omitempty"`
+	Customer              *Customer        `json:"customer,omitempty" bson:"customer,omitempty"`
+	LocationID            int64            `json:"location_id,omitempty" bson:"location_id,omitempty"`
+	UserID                int64            `json:"user_id,omitempty" bson:"user_id,omitempty"`
+	CreatedAt             *time.Time       `json:"created_at,omitempty" bson:"created_at,omitempty"`
+	UpdatedAt             *time.Time       `json:"updated_at,omitempty" bson:"updated_at,omitempty"`
+	CompletedAt           *time.Time       `json:"completed_at,omitempty" bson:"completed_at,omitempty"`
+	ClosedAt              *time.Time       `json:"closed_at,omitempty" bson:"closed_at,omitempty"`
+}
+
+// AbandonedCheckoutListOptions represents the options available when listing
+// or counting abandoned checkouts. Status is either "open", the default, or
+// "closed".
+type AbandonedCheckoutListOptions struct {
+	ListOptions
+	Status string `url:"status,omitempty"`
+}
+
+// AbandonedCheckoutsResource represents the result from the checkouts.json endpoint
+type AbandonedCheckoutsResource struct {
+	Checkouts []AbandonedCheckout `json:"checkouts" bson:"checkouts"`
+}
+
+// List abandoned checkouts
+func (s *AbandonedCheckoutServiceOp) List(options interface{}) ([]AbandonedCheckout, error) {
+	checkouts, _, err := s.ListWithPagination(options)
+	if err != nil {
+		return nil, err
+	}
+	return checkouts, nil
+}
+
+// ListWithPagination lists abandoned checkouts and return pagination to retrieve next/previous results.
+func (s *AbandonedCheckoutServiceOp) ListWithPagination(options interface{}) ([]AbandonedCheckout, *Pagination, error) {
+	path := fmt.Sprintf("%s.json", abandonedCheckoutsBasePath)
+	resource := new(AbandonedCheckoutsResource)
+	headers := http.Header{}
+
+	headers, err := s.client.createAndDoGetHeaders("GET", path, nil, options, resource)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Extract pagination info from header
+	linkHeader := headers.Get("Link")
+
+	pagination, err := extractPagination(linkHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource.Checkouts, pagination, nil
+}
+
+// Count abandoned checkouts
+func (s *AbandonedCheckoutServiceOp) Count(options interface{}) (int, error) {
+	path := fmt.Sprintf("%s/count.json", abandonedCheckoutsBasePath)
+	return s.client.Count(path, options)
+}
+
+// Recover creates a draft order from an abandoned checkout with the
+// DraftOrder service, so its invoice can be sent to the customer. The
+// optional discount is applied to the whole draft order.
+func (s *AbandonedCheckoutServiceOp) Recover(checkout AbandonedCheckout, discount *AppliedDiscount) (*DraftOrder, error) {
+	return s.client.DraftOrder.Create(checkout.DraftOrder(discount))
+}
+
+// DraftOrder returns a draft order for the same customer, addresses, items
+// and shipping as the abandoned checkout, with the given discount. Items of
+// deleted variants are kept as custom items.
+func (c AbandonedCheckout) DraftOrder(discount *AppliedDiscount) DraftOrder {
+	draftOrder := DraftOrder{
+		Email:           c.Email,
+		BillingAddress:  c.BillingAddress,
+		ShippingAddress: c.ShippingAddress,
+		Note:            c.Note,
+		NoteAttributes:  c.NoteAttributes,
+		TaxesIncluded:   c.TaxesIncluded,
+		AppliedDiscount: discount,
+	}
+
+	if c.Customer != nil && c.Customer.ID != 0 {
+		draftOrder.Customer = &Customer{ID: c.Customer.ID}
+	}
+
+	for _, item := range c.LineItems {
+		lineItem := LineItem{
+			VariantID:  item.VariantID,
+			Quantity:   item.Quantity,
+			Properties: item.Properties,
+		}
+		if item.VariantID == 0 {
+			lineItem.Title = item.Title
+			lineItem.Price = item.Price
+			lineItem.Grams = item.Grams
+			lineItem.Taxable = item.Taxable
+			lineItem.RequiresShipping = item.RequiresShipping
+		}
+		draftOrder.LineItems = append(draftOrder.LineItems, lineItem)
+	}
+
+	if len(c.ShippingLines) > 0 {
+		shippingLine := c.ShippingLines[0]
+		draftOrder.ShippingLine = &ShippingLine{
+			Custom: true,
+			Title:  shippingLine.Title,
+			Price:  shippingLine.Price,
+		}
+	}
+
+	return draftOrder
+}
@@ -0,0 +1,181 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func abandonedCheckoutTests(t *testing.T, checkout AbandonedCheckout) {
+	// Check that ID is assigned to the returned checkout
+	expectedID := int64(450789469)
+	if checkout.ID != expectedID {
+		t.Errorf("AbandonedCheckout.ID returned %+v, expected %+v", checkout.ID, expectedID)
+	}
+
+	expectedTotalPrice := decimal.RequireFromString("409.94")
+	if checkout.TotalPrice == nil || !checkout.TotalPrice.Equal(expectedTotalPrice) {
+		t.Errorf("AbandonedCheckout.TotalPrice returned %v, expected %v", checkout.TotalPrice, expectedTotalPrice)
+	}
+
+	if len(checkout.LineItems) != 2 || checkout.LineItems[0].VariantID != 39072856 {
+		t.Errorf("AbandonedCheckout.LineItems returned %+v, expected 2 line items", checkout.LineItems)
+	}
+
+	if checkout.Customer == nil || checkout.Customer.ID != 207119551 {
+		t.Errorf("AbandonedCheckout.Customer returned %+v, expected customer 207119551", checkout.Customer)
+	}
+
+	if checkout.ShippingAddress == nil || checkout.ShippingAddress.City != "Louisville" {
+		t.Errorf("AbandonedCheckout.ShippingAddress returned %+v, expected an address in Louisville", checkout.ShippingAddress)
+	}
+}
+
+func TestAbandonedCheckoutList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponderWithQuery("GET", fmt.Sprintf("https://"+testHost+"/%s/checkouts.json", client.pathPrefix),
+		map[string]string{"status": "open", "created_at_min": "2012-08-01T00:00:00Z"},
+		httpmock.NewBytesResponder(200, loadFixture("abandoned_checkouts.json")))
+
+	createdAtMin := time.Date(2012, time.August, 1, 0, 0, 0, 0, time.UTC)
+	options := AbandonedCheckoutListOptions{
+		ListOptions: ListOptions{CreatedAtMin: &createdAtMin},
+		Status:      "open",
+	}
+	checkouts, err := client.AbandonedCheckout.List(options)
+	if err != nil {
+		t.Errorf("AbandonedCheckout.List returned error: %v", err)
+	}
+
+	if len(checkouts) != 1 {
+		t.Fatalf("AbandonedCheckout.List got %d checkouts, expected 1", len(checkouts))
+	}
+	abandonedCheckoutTests(t, checkouts[0])
+}
+
+func TestAbandonedCheckoutListWithPagination(t *testing.T) {
+	setup()
+	defer teardown()
+
+	response := httpmock.NewBytesResponse(200, loadFixture("abandoned_checkouts.json"))
+	response.Header.Set("Link", `<http://valid.url?page_info=foo&limit=1>; rel="next"`)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/checkouts.json", client.pathPrefix),
+		httpmock.ResponderFromResponse(response))
+
+	checkouts, pagination, err := client.AbandonedCheckout.ListWithPagination(nil)
+	if err != nil {
+		t.Errorf("AbandonedCheckout.ListWithPagination returned error: %v", err)
+	}
+
+	if len(checkouts) != 1 {
+		t.Fatalf("AbandonedCheckout.ListWithPagination got %d checkouts, expected 1", len(checkouts))
+	}
+
+	expectedPagination := &Pagination{
+		NextPageOptions: &ListOptions{PageInfo: PString("foo"), Limit: PInt(1)},
+	}
+	if !reflect.DeepEqual(pagination, expectedPagination) {
+		t.Errorf("AbandonedCheckout.ListWithPagination returned pagination %+v, expected %+v", pagination, expectedPagination)
+	}
+}
+
+func TestAbandonedCheckoutCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponderWithQuery("GET", fmt.Sprintf("https://"+testHost+"/%s/checkouts/count.json", client.pathPrefix),
+		map[string]string{"status": "closed"},
+		httpmock.NewStringResponder(200, `{"count": 4}`))
+
+	cnt, err := client.AbandonedCheckout.Count(AbandonedCheckoutListOptions{Status: "closed"})
+	if err != nil {
+		t.Errorf("AbandonedCheckout.Count returned error: %v", err)
+	}
+
+	expected := 4
+	if cnt != expected {
+		t.Errorf("AbandonedCheckout.Count returned %d, expected %d", cnt, expected)
+	}
+}
+
+func TestAbandonedCheckoutDraftOrder(t *testing.T) {
+	resource := new(AbandonedCheckoutsResource)
+	if err := json.Unmarshal(loadFixture("abandoned_checkouts.json"), resource); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+	checkout := resource.Checkouts[0]
+
+	discount := &AppliedDiscount{Description: "Come back", Value: "10.0", ValueType: "percentage"}
+	draftOrder := checkout.DraftOrder(discount)
+
+	if draftOrder.Email != checkout.Email || draftOrder.ShippingAddress != checkout.ShippingAddress || draftOrder.BillingAddress != checkout.BillingAddress {
+		t.Errorf("AbandonedCheckout.DraftOrder returned %+v, expected the email and addresses of the checkout", draftOrder)
+	}
+	if draftOrder.AppliedDiscount != discount {
+		t.Errorf("AbandonedCheckout.DraftOrder returned discount %+v, expected %+v", draftOrder.AppliedDiscount, discount)
+	}
+	if !reflect.DeepEqual(draftOrder.Customer, &Customer{ID: 207119551}) {
+		t.Errorf("AbandonedCheckout.DraftOrder returned customer %+v, expected customer 207119551", draftOrder.Customer)
+	}
+
+	price := decimal.RequireFromString("199.00")
+	expectedLineItems := []LineItem{
+		{VariantID: 39072856, Quantity: 1},
+		{Title: "Engraving", Quantity: 1, Price: &price, Grams: 200, Taxable: true, RequiresShipping: true},
+	}
+	if len(draftOrder.LineItems) != 2 || !reflect.DeepEqual(draftOrder.LineItems[0], expectedLineItems[0]) {
+		t.Fatalf("AbandonedCheckout.DraftOrder returned line items %+v, expected %+v", draftOrder.LineItems, expectedLineItems)
+	}
+	custom := draftOrder.LineItems[1]
+	if custom.VariantID != 0 || custom.Title != "Engraving" || custom.Price == nil || !custom.Price.Equal(price) {
+		t.Errorf("AbandonedCheckout.DraftOrder returned custom line item %+v, expected %+v", custom, expectedLineItems[1])
+	}
+
+	if draftOrder.ShippingLine == nil || !draftOrder.ShippingLine.Custom || draftOrder.ShippingLine.Title != "Free Shipping" {
+		t.Errorf("AbandonedCheckout.DraftOrder returned shipping line %+v, expected custom Free Shipping", draftOrder.ShippingLine)
+	}
+}
+
+func TestAbandonedCheckoutRecover(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var sent map[string]DraftOrder
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://"+testHost+"/%s/draft_orders.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&sent); err != nil {
+				return nil, err
+			}
+			return httpmock.NewBytesResponse(201, loadFixture("draft_order.json")), nil
+		})
+
+	checkout := AbandonedCheckout{
+		ID:        450789469,
+		Email:     "bob.norman@mail.example.com",
+		LineItems: []LineItem{{VariantID: 39072856, Quantity: 2, Title: "IPod Nano - 8gb"}},
+	}
+	discount := &AppliedDiscount{Description: "Come back", Value: "10.0", ValueType: "percentage"}
+
+	draftOrder, err := client.AbandonedCheckout.Recover(checkout, discount)
+	if err != nil {
+		t.Fatalf("AbandonedCheckout.Recover returned error: %v", err)
+	}
+	draftOrderTests(t, *draftOrder)
+
+	expected := DraftOrder{
+		Email:           "bob.norman@mail.example.com",
+		LineItems:       []LineItem{{VariantID: 39072856, Quantity: 2}},
+		AppliedDiscount: discount,
+	}
+	if !reflect.DeepEqual(sent["draft_order"], expected) {
+		t.Errorf("AbandonedCheckout.Recover sent %+v, expected %+v", sent["draft_order"], expected)
+	}
+}
@@ -0,0 +1,222 @@
+package goshopify
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const articlesBasePath = "articles"
+const articlesResourceName = "articles"
+
+// ArticleService is an interface for interfacing with the article endpoints
+// of the Shopify API. Articles belong to a blog.
+// See: https://shopify.dev/docs/api/admin-rest/2023-01/resources/article
+type ArticleService interface {
+	List(int64, interface{}) ([]Article, error)
+	ListWithPagination(int64, interface{}) ([]Article, *Pagination, error)
+	Count(int64, interface{}) (int, error)
+	Get(int64, int64, interface{}) (*Article, error)
+	Create(int64, Article) (*Article, error)
+	Update(int64, Article) (*Article, error)
+	Delete(int64, int64) error
+	ListAuthors() ([]string, error)
+	ListTags(interface{}) ([]string, error)
+	ListBlogTags(int64, interface{}) ([]string, error)
+
+	// MetafieldsService used for Article resource to communicate with Metafields resource
+	MetafieldsService
+}
+
+// ArticleServiceOp handles communication with the article related methods of
+// the Shopify API.
+type ArticleServiceOp struct {
+	client *Client
+}
+
+// Article represents a Shopify blog article
+type Article struct {
+	ID                int64         `json:"id,omitempty" bson:"id,omitempty"`
+	BlogID            int64         `json:"blog_id,omitempty" bson:"blog_id,omitempty"`
+	Title             string        `json:"title,omitempty" bson:"title,omitempty"`
+	Author            string        `json:"author,omitempty" bson:"author,omitempty"`
+	BodyHTML          string        `json:"body_html,omitempty" bson:"body_html,omitempty"`
+	SummaryHTML       string        `json:"summary_html,omitempty" bson:"summary_html,omitempty"`
+	Handle            string        `json:"handle,omitempty" bson:"handle,omitempty"`
+	Tags              string        `json:"tags,omitempty" bson:"tags,omitempty"`
+	TemplateSuffix    string        `json:"template_suffix,omitempty" bson:"template_suffix,omitempty"`
+	UserID            int64         `json:"user_id,omitempty" bson:"user_id,omitempty"`
+	Published         *bool         `json:"published,omitempty" bson:"published,omitempty"`
+	PublishedAt       *time.Time    `json:"published_at,omitempty" bson:"published_at,omitempty"`
+	CreatedAt         *time.Time    `json:"created_at,omitempty" bson:"created_at,omitempty"`
+	UpdatedAt         *time.Time    `json:"updated_at,omitempty" bson:"updated_at,omitempty"`
+	Image             *ArticleImage `json:"image,omitempty" bson:"image,omitempty"`
+	Metafields        []Metafield   `json:"metafields,omitempty" bson:"metafields,omitempty"`
+	AdminGraphqlAPIID string        `json:"admin_graphql_api_id,omitempty" bson:"admin_graphql_api_id,omitempty"`
+}
+
+// ArticleImage represents the image of an article. Set either Src or a base64
+// encoded Attachment to upload an image.
+type ArticleImage struct {
+	Src        string     `json:"src,omitempty" bson:"src,omitempty"`
+	Attachment string     `json:"attachment,omitempty" bson:"attachment,omitempty"`
+	Alt        string     `json:"alt,omitempty" bson:"alt,omitempty"`
+	Width      int        `json:"width,omitempty" bson:"width,omitempty"`
+	Height     int        `json:"height,omitempty" bson:"height,omitempty"`
+	CreatedAt  *time.Time `json:"created_at,omitempty" bson:"created_at,omitempty"`
+}
+
+// ArticleTagsOptions represents the options available when listing the tags
+// of articles
+type ArticleTagsOptions struct {
+	Limit   int  `url:"limit,omitempty"`
+	Popular bool `url:"popular,omitempty,int"`
+}
+
+// ArticleResource represents the result from the blogs/X/articles/Y.json endpoint
+type ArticleResource struct {
+	Article *Article `json:"article" bson:"article"`
+}
+
+// ArticlesResource represents the result from the blogs/X/articles.json endpoint
+type ArticlesResource struct {
+	Articles []Article `json:"articles" bson:"articles"`
+}
+
+// ArticleAuthorsResource represents the result from the articles/authors.json endpoint
+type ArticleAuthorsResource struct {
+	Authors []string `json:"authors" bson:"authors"`
+}
+
+// ArticleTagsResource represents the result from the articles/tags.json endpoint
+type ArticleTagsResource struct {
+	Tags []string `json:"tags" bson:"tags"`
+}
+
+// List articles of a blog
+func (s *ArticleServiceOp) List(blogID int64, options interface{}) ([]Article, error) {
+	articles, _, err := s.ListWithPagination(blogID, options)
+	if err != nil {
+		return nil, err
+	}
+	return articles, nil
+}
+
+// ListWithPagination lists articles of a blog and return pagination to retrieve next/previous results.
+func (s *ArticleServiceOp) ListWithPagination(blogID int64, options interface{}) ([]Article, *Pagination, error) {
+	path := fmt.Sprintf("%s/%d/%s.json", blogsBasePath, blogID, articlesResourceName)
+	resource := new(ArticlesResource)
+	headers := http.Header{}
+
+	headers, err := s.client.createAndDoGetHeaders("GET", path, nil, options, resource)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Extract pagination info from header
+	linkHeader := headers.Get("Link")
+
+	pagination, err := extractPagination(linkHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource.Articles, pagination, nil
+}
+
+// Count articles of a blog
+func (s *ArticleServiceOp) Count(blogID int64, options interface{}) (int, error) {
+	path := fmt.Sprintf("%s/%d/%s/count.json", blogsBasePath, blogID, articlesResourceName)
+	return s.client.Count(path, options)
+}
+
+// Get individual article of a blog
+func (s *ArticleServiceOp) Get(blogID int64, articleID int64, options interface{}) (*Article, error) {
+	path := fmt.Sprintf("%s/%d/%s/%d.json", blogsBasePath, blogID, articlesResourceName, articleID)
+	resource := new(ArticleResource)
+	err := s.client.Get(path, resource, options)
+	return resource.Article, err
+}
+
+// Create a new article in a blog
+func (s *ArticleServiceOp) Create(blogID int64, article Article) (*Article, error) {
+	path := fmt.Sprintf("%s/%d/%s.json", blogsBasePath, blogID, articlesResourceName)
+	wrappedData := ArticleResource{Article: &article}
+	resource := new(ArticleResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.Article, err
+}
+
+// Update an existing article of a blog
+func (s *ArticleServiceOp) Update(blogID int64, article Article) (*Article, error) {
+	path := fmt.Sprintf("%s/%d/%s/%d.json", blogsBasePath, blogID, articlesResourceName, article.ID)
+	wrappedData := ArticleResource{Article: &article}
+	resource := new(ArticleResource)
+	err := s.client.Put(path, wrappedData, resource)
+	return resource.Article, err
+}
+
+// Delete an existing article of a blog
+func (s *ArticleServiceOp) Delete(blogID int64, articleID int64) error {
+	return s.client.Delete(fmt.Sprintf("%s/%d/%s/%d.json", blogsBasePath, blogID, articlesResourceName, articleID))
+}
+
+// ListAuthors lists the authors of all the articles
+func (s *ArticleServiceOp) ListAuthors() ([]string, error) {
+	path := fmt.Sprintf("%s/authors.json", articlesBasePath)
+	resource := new(ArticleAuthorsResource)
+	err := s.client.Get(path, resource, nil)
+	return resource.Authors, err
+}
+
+// ListTags lists the tags of all the articles
+func (s *ArticleServiceOp) ListTags(options interface{}) ([]string, error) {
+	path := fmt.Sprintf("%s/tags.json", articlesBasePath)
+	resource := new(ArticleTagsResource)
+	err := s.client.Get(path, resource, options)
+	return resource.Tags, err
+}
+
+// ListBlogTags lists the tags of the articles of a blog
+func (s *ArticleServiceOp) ListBlogTags(blogID int64, options interface{}) ([]string, error) {
+	path := fmt.Sprintf("%s/%d/%s/tags.json", blogsBasePath, blogID, articlesResourceName)
+	resource := new(ArticleTagsResource)
+	err := s.client.Get(path, resource, options)
+	return resource.Tags, err
+}
+
+// ListMetafields for an article
+func (s *ArticleServiceOp) ListMetafields(articleID int64, options interface{}) ([]Metafield, error) {
+	metafieldService := &MetafieldServiceOp{client: s.client, resource: articlesResourceName, resourceID: articleID}
+	return metafieldService.List(options)
+}
+
+// CountMetafields for an article
+func (s *ArticleServiceOp) CountMetafields(articleID int64, options interface{}) (int, error) {
+	metafieldService := &MetafieldServiceOp{client: s.client, resource: articlesResourceName, resourceID: articleID}
+	return metafieldService.Count(options)
+}
+
+// GetMetafield for an article
+func (s *ArticleServiceOp) GetMetafield(articleID int64, metafieldID int64, options interface{}) (*Metafield, error) {
+	metafieldService := &MetafieldServiceOp{client: s.client, resource: articlesResourceName, resourceID: articleID}
+	return metafieldService.Get(metafieldID, options)
+}
+
+// CreateMetafield for an article
+func (s *ArticleServiceOp) CreateMetafield(articleID int64, metafield Metafield) (*Metafield, error) {
+	metafieldService := &MetafieldServiceOp{client: s.client, resource: articlesResourceName, resourceID: articleID}
+	return metafieldService.Create(metafield)
+}
+
+// UpdateMetafield for an article
+func (s *ArticleServiceOp) UpdateMetafield(articleID int64, metafield Metafield) (*Metafield, error) {
+	metafieldService := &MetafieldServiceOp{client: s.client, resource: articlesResourceName, resourceID: articleID}
+	return metafieldService.Update(metafield)
+}
+
+// DeleteMetafield for an article
+func (s *ArticleServiceOp) DeleteMetafield(articleID int64, metafieldID int64) error {
+	metafieldService := &MetafieldServiceOp{client: s.client, resource: articlesResourceName, resourceID: articleID}
+	return metafieldService.Delete(metafieldID)
+}
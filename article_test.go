@@ -0,0 +1,275 @@
+package goshopify
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func articleTests(t *testing.T, article Article) {
+	// Check that ID is assigned to the returned article
+	expectedID := int64(134645308)
+	if article.ID != expectedID {
+		t.Errorf("Article.ID returned %+v, expected %+v", article.ID, expectedID)
+	}
+
+	// Check that the BlogID value is assigned to the returned article
+	expectedBlogID := int64(241253187)
+	if article.BlogID != expectedBlogID {
+		t.Errorf("Article.BlogID returned %+v, expected %+v", article.BlogID, expectedBlogID)
+	}
+
+	expectedTags := "Announcing, Mystery"
+	if article.Tags != expectedTags {
+		t.Errorf("Article.Tags returned %+v, expected %+v", article.Tags, expectedTags)
+	}
+
+	if article.Image == nil || article.Image.Alt != "iPod" || article.Image.Width != 123 {
+		t.Errorf("Article.Image returned %+v, expected the iPod image", article.Image)
+	}
+}
+
+func TestArticleList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/blogs/241253187/articles.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("articles.json")))
+
+	articles, err := client.Article.List(241253187, nil)
+	if err != nil {
+		t.Errorf("Article.List returned error: %v", err)
+	}
+
+	if len(articles) != 1 {
+		t.Fatalf("Article.List got %d articles, expected 1", len(articles))
+	}
+	articleTests(t, articles[0])
+}
+
+func TestArticleListWithPagination(t *testing.T) {
+	setup()
+	defer teardown()
+
+	response := httpmock.NewBytesResponse(200, loadFixture("articles.json"))
+	response.Header.Set("Link", `<http://valid.url?page_info=foo&limit=1>; rel="next"`)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/blogs/241253187/articles.json", client.pathPrefix),
+		httpmock.ResponderFromResponse(response))
+
+	articles, pagination, err := client.Article.ListWithPagination(241253187, ListOptions{Limit: PInt(1)})
+	if err != nil {
+		t.Errorf("Article.ListWithPagination returned error: %v", err)
+	}
+
+	if len(articles) != 1 {
+		t.Fatalf("Article.ListWithPagination got %d articles, expected 1", len(articles))
+	}
+
+	expectedPagination := &Pagination{
+		NextPageOptions: &ListOptions{PageInfo: PString("foo"), Limit: PInt(1)},
+	}
+	if !reflect.DeepEqual(pagination, expectedPagination) {
+		t.Errorf("Article.ListWithPagination returned pagination %+v, expected %+v", pagination, expectedPagination)
+	}
+}
+
+func TestArticleCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/blogs/241253187/articles/count.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"count": 4}`))
+
+	cnt, err := client.Article.Count(241253187, nil)
+	if err != nil {
+		t.Errorf("Article.Count returned error: %v", err)
+	}
+
+	expected := 4
+	if cnt != expected {
+		t.Errorf("Article.Count returned %d, expected %d", cnt, expected)
+	}
+}
+
+func TestArticleGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/blogs/241253187/articles/134645308.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("article.json")))
+
+	article, err := client.Article.Get(241253187, 134645308, nil)
+	if err != nil {
+		t.Errorf("Article.Get returned error: %v", err)
+	}
+
+	articleTests(t, *article)
+}
+
+func TestArticleCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://"+testHost+"/%s/blogs/241253187/articles.json", client.pathPrefix),
+		httpmock.NewBytesResponder(201, loadFixture("article.json")))
+
+	published := true
+	article := Article{
+		Title:     "get on the train now",
+		Author:    "Dennis",
+		BodyHTML:  "<p>Do <em>you</em> have an <strong>IPod</strong> yet?</p>",
+		Tags:      "Announcing, Mystery",
+		Published: &published,
+		Image:     &ArticleImage{Src: "https://cdn.shopify.com/s/files/1/0005/4838/0009/articles/ipod.jpg", Alt: "iPod"},
+	}
+
+	returnedArticle, err := client.Article.Create(241253187, article)
+	if err != nil {
+		t.Errorf("Article.Create returned error: %v", err)
+	}
+
+	articleTests(t, *returnedArticle)
+}
+
+func TestArticleUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://"+testHost+"/%s/blogs/241253187/articles/134645308.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("article.json")))
+
+	article := Article{
+		ID:    134645308,
+		Title: "get on the train now",
+	}
+
+	returnedArticle, err := client.Article.Update(241253187, article)
+	if err != nil {
+		t.Errorf("Article.Update returned error: %v", err)
+	}
+
+	articleTests(t, *returnedArticle)
+}
+
+func TestArticleDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("https://"+testHost+"/%s/blogs/241253187/articles/134645308.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.Article.Delete(241253187, 134645308)
+	if err != nil {
+		t.Errorf("Article.Delete returned error: %v", err)
+	}
+}
+
+func TestArticleListAuthors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/articles/authors.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"authors": ["Dennis", "John", "Rob"]}`))
+
+	authors, err := client.Article.ListAuthors()
+	if err != nil {
+		t.Errorf("Article.ListAuthors returned error: %v", err)
+	}
+
+	expected := []string{"Dennis", "John", "Rob"}
+	if !reflect.DeepEqual(authors, expected) {
+		t.Errorf("Article.ListAuthors returned %+v, expected %+v", authors, expected)
+	}
+}
+
+func TestArticleListTags(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponderWithQuery("GET", fmt.Sprintf("https://"+testHost+"/%s/articles/tags.json", client.pathPrefix),
+		map[string]string{"limit": "1", "popular": "1"},
+		httpmock.NewStringResponder(200, `{"tags": ["Mystery"]}`))
+
+	tags, err := client.Article.ListTags(ArticleTagsOptions{Limit: 1, Popular: true})
+	if err != nil {
+		t.Errorf("Article.ListTags returned error: %v", err)
+	}
+
+	expected := []string{"Mystery"}
+	if !reflect.DeepEqual(tags, expected) {
+		t.Errorf("Article.ListTags returned %+v, expected %+v", tags, expected)
+	}
+}
+
+func TestArticleListBlogTags(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/blogs/241253187/articles/tags.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"tags": ["Announcing", "Mystery"]}`))
+
+	tags, err := client.Article.ListBlogTags(241253187, nil)
+	if err != nil {
+		t.Errorf("Article.ListBlogTags returned error: %v", err)
+	}
+
+	expected := []string{"Announcing", "Mystery"}
+	if !reflect.DeepEqual(tags, expected) {
+		t.Errorf("Article.ListBlogTags returned %+v, expected %+v", tags, expected)
+	}
+}
+
+func TestArticleListMetafields(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/articles/1/metafields.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"metafields": [{"id":1},{"id":2}]}`))
+
+	metafields, err := client.Article.ListMetafields(1, nil)
+	if err != nil {
+		t.Errorf("Article.ListMetafields() returned error: %v", err)
+	}
+
+	expected := []Metafield{{ID: 1}, {ID: 2}}
+	if !reflect.DeepEqual(metafields, expected) {
+		t.Errorf("Article.ListMetafields() returned %+v, expected %+v", metafields, expected)
+	}
+}
+
+func TestArticleCreateMetafield(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://"+testHost+"/%s/articles/1/metafields.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("metafield.json")))
+
+	metafield := Metafield{
+		Key:       "app_key",
+		Value:     "app_value",
+		Type:      "single_line_text_field",
+		Namespace: "affiliates",
+	}
+
+	returnedMetafield, err := client.Article.CreateMetafield(1, metafield)
+	if err != nil {
+		t.Errorf("Article.CreateMetafield() returned error: %v", err)
+	}
+
+	MetafieldTests(t, *returnedMetafield)
+}
+
+func TestArticleDeleteMetafield(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("https://"+testHost+"/%s/articles/1/metafields/2.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.Article.DeleteMetafield(1, 2)
+	if err != nil {
+		t.Errorf("Article.DeleteMetafield() returned error: %v", err)
+	}
+}
@@ -0,0 +1,329 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const carrierServicesBasePath = "carrier_services"
+
+// shippingRateDateFormat is the format of the delivery dates of shipping rates
+const shippingRateDateFormat = "2006-01-02 15:04:05 -0700"
+
+// CarrierServiceService is an interface for interfacing with the carrier
+// services endpoints of the Shopify API.
+// See: https://shopify.dev/docs/api/admin-rest/2023-01/resources/carrierservice
+type CarrierServiceService interface {
+	List() ([]CarrierService, error)
+	Get(int64) (*CarrierService, error)
+	Create(CarrierService) (*CarrierService, error)
+	Update(CarrierService) (*CarrierService, error)
+	Delete(int64) error
+}
+
+// CarrierServiceServiceOp handles communication with the carrier service
+// related methods of the Shopify API.
+type CarrierServiceServiceOp struct {
+	client *Client
+}
+
+// CarrierService represents a Shopify carrier service, which provides real
+// time shipping rates from its callback URL.
+type CarrierService struct {
+	ID                 int64  `json:"id,omitempty" bson:"id,omitempty"`
+	Name               string `json:"name,omitempty" bson:"name,omitempty"`
+	CallbackURL        string `json:"callback_url,omitempty" bson:"callback_url,omitempty"`
+	Format             string `json:"format,omitempty" bson:"format,omitempty"`
+	CarrierServiceType string `json:"carrier_service_type,omitempty" bson:"carrier_service_type,omitempty"`
+	Active             bool   `json:"active" bson:"active"`
+	ServiceDiscovery   bool   `json:"service_discovery" bson:"service_discovery"`
+	AdminGraphqlAPIID  string `json:"admin_graphql_api_id,omitempty" bson:"admin_graphql_api_id,omitempty"`
+}
+
+// CarrierServiceResource represents the result from the carrier_services/X.json endpoint
+type CarrierServiceResource struct {
+	CarrierService *CarrierService `json:"carrier_service" bson:"carrier_service"`
+}
+
+// CarrierServicesResource represents the result from the carrier_services.json endpoint
+type CarrierServicesResource struct {
+	CarrierServices []CarrierService `json:"carrier_services" bson:"carrier_services"`
+}
+
+// List carrier services
+func (s *CarrierServiceServiceOp) List() ([]CarrierService, error) {
+	path := fmt.Sprintf("%s.json", carrierServicesBasePath)
+	resource := new(CarrierServicesResource)
+	err := s.client.Get(path, resource, nil)
+	return resource.CarrierServices, err
+}
+
+// Get individual carrier service
+func (s *CarrierServiceServiceOp) Get(carrierServiceID int64) (*CarrierService, error) {
+	path := fmt.Sprintf("%s/%d.json", carrierServicesBasePath, carrierServiceID)
+	resource := new(CarrierServiceResource)
+	err := s.client.Get(path, resource, nil)
+	return resource.CarrierService, err
+}
+
+// Create a new carrier service
+func (s *CarrierServiceServiceOp) Create(carrierService CarrierService) (*CarrierService, error) {
+	path := fmt.Sprintf("%s.json", carrierServicesBasePath)
+	wrappedData := CarrierServiceResource{CarrierService: &carrierService}
+	resource := new(CarrierServiceResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.CarrierService, err
+}
+
+// Update an existing carrier service
+func (s *CarrierServiceServiceOp) Update(carrierService CarrierService) (*CarrierService, error) {
+	path := fmt.Sprintf("%s/%d.json", carrierServicesBasePath, carrierService.ID)
+	wrappedData := CarrierServiceResource{CarrierService: &carrierService}
+	resource := new(CarrierServiceResource)
+	err := s.client.Put(path, wrappedData, resource)
+	return resource.CarrierService, err
+}
+
+// Delete an existing carrier service
+func (s *CarrierServiceServiceOp) Delete(carrierServiceID int64) error {
+	return s.client.Delete(fmt.Sprintf("%s/%d.json", carrierServicesBasePath, carrierServiceID))
+}
+
+// ShippingRateRequest is the request sent by Shopify to the callback URL of a
+// carrier service to get shipping rates.
+type ShippingRateRequest struct {
+	Origin      ShippingRateAddress `json:"origin"`
+	Destination ShippingRateAddress `json:"destination"`
+	Items       []ShippingRateItem  `json:"items"`
+	Currency    string              `json:"currency"`
+	Locale      string              `json:"locale,omitempty"`
+}
+
+// ShippingRateAddress is the origin or destination of a shipping rate request.
+type ShippingRateAddress struct {
+	Country     string `json:"country"`
+	PostalCode  string `json:"postal_code,omitempty"`
+	Province    string `json:"province,omitempty"`
+	City        string `json:"city,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Address1    string `json:"address1,omitempty"`
+	Address2    string `json:"address2,omitempty"`
+	Address3    string `json:"address3,omitempty"`
+	Phone       string `json:"phone,omitempty"`
+	Fax         string `json:"fax,omitempty"`
+	Email       string `json:"email,omitempty"`
+	AddressType string `json:"address_type,omitempty"`
+	CompanyName string `json:"company_name,omitempty"`
+}
+
+// ShippingRateItem is an item to be shipped. Shopify sends the price in
+// subunits of the currency, Price holds it in units.
+type ShippingRateItem struct {
+	Name               string                 `json:"name"`
+	SKU                string                 `json:"sku,omitempty"`
+	Quantity           int                    `json:"quantity"`
+	Grams              int                    `json:"grams"`
+	Price              decimal.Decimal        `json:"price"`
+	Vendor             string                 `json:"vendor,omitempty"`
+	RequiresShipping   bool                   `json:"requires_shipping"`
+	Taxable            bool                   `json:"taxable"`
+	FulfillmentService string                 `json:"fulfillment_service,omitempty"`
+	Properties         map[string]interface{} `json:"properties,omitempty"`
+	ProductID          int64                  `json:"product_id,omitempty"`
+	VariantID          int64                  `json:"variant_id,omitempty"`
+}
+
+// ShippingRate is a shipping rate returned to Shopify by a carrier service.
+// TotalPrice is in units of the currency, and the currency of the request is
+// used when Currency is empty.
+type ShippingRate struct {
+	ServiceName     string          `json:"service_name"`
+	ServiceCode     string          `json:"service_code"`
+	TotalPrice      decimal.Decimal `json:"total_price"`
+	Description     string          `json:"description,omitempty"`
+	Currency        string          `json:"currency"`
+	MinDeliveryDate *time.Time      `json:"min_delivery_date,omitempty"`
+	MaxDeliveryDate *time.Time      `json:"max_delivery_date,omitempty"`
+	PhoneRequired   bool            `json:"phone_required,omitempty"`
+}
+
+// shippingRateRequestResource is the body of a shipping rate request
+type shippingRateRequestResource struct {
+	Rate *ShippingRateRequest `json:"rate"`
+}
+
+// shippingRatesResource is the body of a shipping rate response
+type shippingRatesResource struct {
+	Rates []ShippingRate `json:"rates"`
+}
+
+// toSubunits converts an amount in units to the subunits used by Shopify.
+// Currencies without subunits are multiplied by 100 too.
+func toSubunits(amount decimal.Decimal) int64 {
+	return amount.Shift(2).Round(0).IntPart()
+}
+
+// UnmarshalJSON converts the price from subunits to units.
+func (i *ShippingRateItem) UnmarshalJSON(data []byte) error {
+	type alias ShippingRateItem
+	aux := struct {
+		*alias
+		Price int64 `json:"price"`
+	}{alias: (*alias)(i)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	i.Price = decimal.New(aux.Price, -2)
+	return nil
+}
+
+// MarshalJSON converts the price from units to subunits.
+func (i ShippingRateItem) MarshalJSON() ([]byte, error) {
+	type alias ShippingRateItem
+	return json.Marshal(struct {
+		alias
+		Price int64 `json:"price"`
+	}{alias: alias(i), Price: toSubunits(i.Price)})
+}
+
+// MarshalJSON converts the total price from units to subunits, and formats
+// the delivery dates the way Shopify expects them.
+func (r ShippingRate) MarshalJSON() ([]byte, error) {
+	type alias ShippingRate
+	aux := struct {
+		alias
+		TotalPrice      string `json:"total_price"`
+		MinDeliveryDate string `json:"min_delivery_date,omitempty"`
+		MaxDeliveryDate string `json:"max_delivery_date,omitempty"`
+	}{alias: alias(r), TotalPrice: fmt.Sprint(toSubunits(r.TotalPrice))}
+	if r.MinDeliveryDate != nil {
+		aux.MinDeliveryDate = r.MinDeliveryDate.Format(shippingRateDateFormat)
+	}
+	if r.MaxDeliveryDate != nil {
+		aux.MaxDeliveryDate = r.MaxDeliveryDate.Format(shippingRateDateFormat)
+	}
+	return json.Marshal(aux)
+}
+
+// Validate checks the fields required to compute shipping rates.
+func (r ShippingRateRequest) Validate() error {
+	var missing []string
+	if r.Origin.Country == "" {
+		missing = append(missing, "origin country")
+	}
+	if r.Destination.Country == "" {
+		missing = append(missing, "destination country")
+	}
+	if len(r.Items) == 0 {
+		missing = append(missing, "items")
+	}
+	if r.Currency == "" {
+		missing = append(missing, "currency")
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("shipping rate request is missing %s", strings.Join(missing, ", "))
+	}
+
+	for i, item := range r.Items {
+		if item.Quantity <= 0 || item.Grams < 0 || item.Price.IsNegative() {
+			return fmt.Errorf("shipping rate request has an invalid item at index %d", i)
+		}
+	}
+	return nil
+}
+
+// Validate checks the fields required by Shopify for a shipping rate.
+func (r ShippingRate) Validate() error {
+	var missing []string
+	if r.ServiceName == "" {
+		missing = append(missing, "service name")
+	}
+	if r.ServiceCode == "" {
+		missing = append(missing, "service code")
+	}
+	if r.Currency == "" {
+		missing = append(missing, "currency")
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("shipping rate is missing %s", strings.Join(missing, ", "))
+	}
+
+	if r.TotalPrice.IsNegative() {
+		return errors.New("shipping rate has a negative total price")
+	}
+	if r.MinDeliveryDate != nil && r.MaxDeliveryDate != nil && r.MaxDeliveryDate.Before(*r.MinDeliveryDate) {
+		return errors.New("shipping rate has a max delivery date before its min delivery date")
+	}
+	return nil
+}
+
+// ShippingRateProvider is implemented by carrier services to compute the
+// shipping rates served by CarrierServiceHandler.
+type ShippingRateProvider interface {
+	ShippingRates(ShippingRateRequest) ([]ShippingRate, error)
+}
+
+// CarrierServiceHandler is an http.Handler answering the shipping rate
+// requests sent to the callback URL of a carrier service. The requests are
+// verified with App.VerifyWebhookRequest and validated before Provider is
+// called, and the returned rates are validated before being sent.
+type CarrierServiceHandler struct {
+	App      App
+	Provider ShippingRateProvider
+}
+
+// NewCarrierServiceHandler returns a CarrierServiceHandler for the app
+func NewCarrierServiceHandler(app App, provider ShippingRateProvider) *CarrierServiceHandler {
+	return &CarrierServiceHandler{App: app, Provider: provider}
+}
+
+// ServeHTTP implements http.Handler
+func (h *CarrierServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	if !h.App.VerifyWebhookRequest(r) {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	resource := shippingRateRequestResource{}
+	if err := json.NewDecoder(r.Body).Decode(&resource); err != nil || resource.Rate == nil {
+		http.Error(w, "invalid shipping rate request", http.StatusBadRequest)
+		return
+	}
+	if err := resource.Rate.Validate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	rates, err := h.Provider.ShippingRates(*resource.Rate)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	response := shippingRatesResource{Rates: make([]ShippingRate, len(rates))}
+	for i, rate := range rates {
+		if rate.Currency == "" {
+			rate.Currency = resource.Rate.Currency
+		}
+		if err := rate.Validate(); err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		response.Rates[i] = rate
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,307 @@
+package goshopify
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func carrierServiceTests(t *testing.T, carrierService CarrierService) {
+	expected := CarrierService{
+		ID:                 1036894959,
+		Name:               "Shipping Rate Provider",
+		CallbackURL:        "http://shippingrateprovider.com/",
+		Format:             "json",
+		CarrierServiceType: "api",
+		Active:             true,
+		ServiceDiscovery:   true,
+		AdminGraphqlAPIID:  "gid://shopify/DeliveryCarrierService/1036894959",
+	}
+	if !reflect.DeepEqual(carrierService, expected) {
+		t.Errorf("CarrierService returned %+v, expected %+v", carrierService, expected)
+	}
+}
+
+func TestCarrierServiceList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/carrier_services.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("carrier_services.json")))
+
+	carrierServices, err := client.CarrierService.List()
+	if err != nil {
+		t.Errorf("CarrierService.List returned error: %v", err)
+	}
+
+	if len(carrierServices) != 1 {
+		t.Fatalf("CarrierService.List got %d carrier services, expected 1", len(carrierServices))
+	}
+	carrierServiceTests(t, carrierServices[0])
+}
+
+func TestCarrierServiceGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/carrier_services/1036894959.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("carrier_service.json")))
+
+	carrierService, err := client.CarrierService.Get(1036894959)
+	if err != nil {
+		t.Errorf("CarrierService.Get returned error: %v", err)
+	}
+
+	carrierServiceTests(t, *carrierService)
+}
+
+func TestCarrierServiceCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://"+testHost+"/%s/carrier_services.json", client.pathPrefix),
+		httpmock.NewBytesResponder(201, loadFixture("carrier_service.json")))
+
+	carrierService := CarrierService{
+		Name:             "Shipping Rate Provider",
+		CallbackURL:      "http://shippingrateprovider.com/",
+		ServiceDiscovery: true,
+	}
+
+	returnedCarrierService, err := client.CarrierService.Create(carrierService)
+	if err != nil {
+		t.Errorf("CarrierService.Create returned error: %v", err)
+	}
+
+	carrierServiceTests(t, *returnedCarrierService)
+}
+
+func TestCarrierServiceUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://"+testHost+"/%s/carrier_services/1036894959.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("carrier_service.json")))
+
+	carrierService := CarrierService{
+		ID:     1036894959,
+		Name:   "Shipping Rate Provider",
+		Active: true,
+	}
+
+	returnedCarrierService, err := client.CarrierService.Update(carrierService)
+	if err != nil {
+		t.Errorf("CarrierService.Update returned error: %v", err)
+	}
+
+	carrierServiceTests(t, *returnedCarrierService)
+}
+
+func TestCarrierServiceDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("https://"+testHost+"/%s/carrier_services/1036894959.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.CarrierService.Delete(1036894959)
+	if err != nil {
+		t.Errorf("CarrierService.Delete returned error: %v", err)
+	}
+}
+
+type testShippingRateProvider struct {
+	request ShippingRateRequest
+	rates   []ShippingRate
+	err     error
+}
+
+func (p *testShippingRateProvider) ShippingRates(req ShippingRateRequest) ([]ShippingRate, error) {
+	p.request = req
+	return p.rates, p.err
+}
+
+func signedRateRequest(body []byte, secret string) *http.Request {
+	req := httptest.NewRequest("POST", "/rates", bytes.NewReader(body))
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	req.Header.Set(shopifyChecksumHeader, base64.StdEncoding.EncodeToString(mac.Sum(nil)))
+	return req
+}
+
+func TestCarrierServiceHandler(t *testing.T) {
+	setup()
+	defer teardown()
+
+	deliveryDate := time.Date(2013, 4, 12, 14, 48, 45, 0, time.FixedZone("", -4*60*60))
+	provider := &testShippingRateProvider{
+		rates: []ShippingRate{
+			{
+				ServiceName:     "canadapost-overnight",
+				ServiceCode:     "ON",
+				TotalPrice:      decimal.RequireFromString("12.95"),
+				Description:     "This is the fastest option by far",
+				Currency:        "CAD",
+				MinDeliveryDate: &deliveryDate,
+				MaxDeliveryDate: &deliveryDate,
+			},
+			{
+				ServiceName: "fedex-2dayground",
+				ServiceCode: "2D",
+				TotalPrice:  decimal.RequireFromString("29.34"),
+			},
+		},
+	}
+	handler := NewCarrierServiceHandler(app, provider)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, signedRateRequest(loadFixture("shipping_rate_request.json"), app.ApiSecret))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("CarrierServiceHandler returned status %d, expected 200: %s", rec.Code, rec.Body)
+	}
+
+	req := provider.request
+	if req.Currency != "USD" || req.Origin.PostalCode != "K2P1L4" || req.Destination.Name != "Bob Norman" {
+		t.Errorf("CarrierServiceHandler decoded request %+v", req)
+	}
+	if len(req.Items) != 1 || req.Items[0].Grams != 1000 || !req.Items[0].Price.Equal(decimal.RequireFromString("19.99")) {
+		t.Errorf("CarrierServiceHandler decoded items %+v, expected a 1000 grams item priced 19.99", req.Items)
+	}
+
+	var response map[string][]map[string]interface{}
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string][]map[string]interface{}{
+		"rates": {
+			{
+				"service_name":      "canadapost-overnight",
+				"service_code":      "ON",
+				"total_price":       "1295",
+				"description":       "This is the fastest option by far",
+				"currency":          "CAD",
+				"min_delivery_date": "2013-04-12 14:48:45 -0400",
+				"max_delivery_date": "2013-04-12 14:48:45 -0400",
+			},
+			{
+				"service_name": "fedex-2dayground",
+				"service_code": "2D",
+				"total_price":  "2934",
+				"currency":     "USD",
+			},
+		},
+	}
+	if !reflect.DeepEqual(response, expected) {
+		t.Errorf("CarrierServiceHandler returned %+v, expected %+v", response, expected)
+	}
+}
+
+func TestCarrierServiceHandlerErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	provider := &testShippingRateProvider{}
+	handler := NewCarrierServiceHandler(app, provider)
+
+	validRequest := loadFixture("shipping_rate_request.json")
+	validRates := []ShippingRate{{ServiceName: "standard", ServiceCode: "ST", TotalPrice: decimal.NewFromInt(5)}}
+
+	cases := []struct {
+		description string
+		req         *http.Request
+		rates       []ShippingRate
+		err         error
+		expected    int
+	}{
+		{
+			"GET request",
+			httptest.NewRequest("GET", "/rates", nil),
+			validRates, nil, http.StatusMethodNotAllowed,
+		},
+		{
+			"invalid signature",
+			signedRateRequest(validRequest, "wrong secret"),
+			validRates, nil, http.StatusUnauthorized,
+		},
+		{
+			"malformed body",
+			signedRateRequest([]byte(`{"rate":`), app.ApiSecret),
+			validRates, nil, http.StatusBadRequest,
+		},
+		{
+			"missing fields",
+			signedRateRequest([]byte(`{"rate":{"origin":{"country":"CA"},"destination":{},"items":[]}}`), app.ApiSecret),
+			validRates, nil, http.StatusBadRequest,
+		},
+		{
+			"provider error",
+			signedRateRequest(validRequest, app.ApiSecret),
+			nil, errors.New("rates unavailable"), http.StatusInternalServerError,
+		},
+		{
+			"invalid rate",
+			signedRateRequest(validRequest, app.ApiSecret),
+			[]ShippingRate{{ServiceName: "standard", TotalPrice: decimal.NewFromInt(-5)}}, nil, http.StatusInternalServerError,
+		},
+	}
+
+	for _, c := range cases {
+		provider.rates = c.rates
+		provider.err = c.err
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, c.req)
+		if rec.Code != c.expected {
+			t.Errorf("CarrierServiceHandler with %s returned status %d, expected %d", c.description, rec.Code, c.expected)
+		}
+	}
+}
+
+func TestShippingRateRequestValidate(t *testing.T) {
+	cases := []struct {
+		request  ShippingRateRequest
+		expected string
+	}{
+		{
+			ShippingRateRequest{},
+			"shipping rate request is missing origin country, destination country, items, currency",
+		},
+		{
+			ShippingRateRequest{
+				Origin:      ShippingRateAddress{Country: "CA"},
+				Destination: ShippingRateAddress{Country: "CA"},
+				Items:       []ShippingRateItem{{Quantity: 1}, {Quantity: 0}},
+				Currency:    "CAD",
+			},
+			"shipping rate request has an invalid item at index 1",
+		},
+		{
+			ShippingRateRequest{
+				Origin:      ShippingRateAddress{Country: "CA"},
+				Destination: ShippingRateAddress{Country: "CA"},
+				Items:       []ShippingRateItem{{Quantity: 1, Price: decimal.NewFromInt(10)}},
+				Currency:    "CAD",
+			},
+			"",
+		},
+	}
+
+	for _, c := range cases {
+		err := c.request.Validate()
+		if (err == nil && c.expected != "") || (err != nil && err.Error() != c.expected) {
+			t.Errorf("ShippingRateRequest.Validate returned %v, expected %q", err, c.expected)
+		}
+	}
+}
@@ -0,0 +1,314 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	catalogIndexPageSize = 250
+	catalogIndexFields   = "id,updated_at,variants"
+)
+
+// CatalogEntry represents a variant in a CatalogIndex
+type CatalogEntry struct {
+	SKU             string `json:"sku,omitempty"`
+	Barcode         string `json:"barcode,omitempty"`
+	ProductID       int64  `json:"product_id"`
+	VariantID       int64  `json:"variant_id"`
+	InventoryItemID int64  `json:"inventory_item_id"`
+}
+
+// CatalogConflict represents a SKU or barcode shared by several variants.
+// Field is either "sku" or "barcode".
+type CatalogConflict struct {
+	Field   string
+	Value   string
+	Entries []CatalogEntry
+}
+
+// CatalogIndex maps the SKUs and barcodes of the variants of the shop to
+// their product, variant and inventory item IDs. Build it with Refresh, which
+// only lists the products updated since the previous call, and keep it
+// between runs with Save and LoadCatalogIndex. It is safe for concurrent use.
+type CatalogIndex struct {
+	mu sync.RWMutex
+
+	// updatedAt is the most recent update of the indexed products
+	updatedAt time.Time
+
+	variants  map[int64]CatalogEntry
+	products  map[int64][]int64
+	bySKU     map[string]map[int64]bool
+	byBarcode map[string]map[int64]bool
+}
+
+// catalogIndexFile is the content of the file written by Save
+type catalogIndexFile struct {
+	UpdatedAt time.Time      `json:"updated_at"`
+	Entries   []CatalogEntry `json:"entries"`
+}
+
+// NewCatalogIndex returns an empty CatalogIndex.
+func NewCatalogIndex() *CatalogIndex {
+	ix := &CatalogIndex{}
+	ix.reset()
+	return ix
+}
+
+// LoadCatalogIndex reads a CatalogIndex written by Save. A missing file
+// results in an empty index.
+func LoadCatalogIndex(path string) (*CatalogIndex, error) {
+	ix := NewCatalogIndex()
+
+	body, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return ix, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var file catalogIndexFile
+	if err := json.Unmarshal(body, &file); err != nil {
+		return nil, err
+	}
+
+	ix.updatedAt = file.UpdatedAt
+	for _, entry := range file.Entries {
+		ix.add(entry)
+	}
+	return ix, nil
+}
+
+// Save writes the index to a file, replacing it atomically.
+func (ix *CatalogIndex) Save(path string) error {
+	ix.mu.RLock()
+	ids := make(map[int64]bool, len(ix.variants))
+	for id := range ix.variants {
+		ids[id] = true
+	}
+	file := catalogIndexFile{UpdatedAt: ix.updatedAt, Entries: ix.entries(ids)}
+	ix.mu.RUnlock()
+
+	body, err := json.Marshal(file)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(body); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// Refresh lists the products updated since the previous refresh, all of them
+// the first time, and updates the index with their variants. Variants removed
+// from a product are removed from the index. Products deleted in Shopify are
+// not listed though, so their variants stay in the index until Rebuild is
+// called, which lists all the products again.
+func (ix *CatalogIndex) Refresh(products ProductService) error {
+	ix.mu.RLock()
+	updatedAt := ix.updatedAt
+	ix.mu.RUnlock()
+
+	options := ProductListOptions{
+		Limit:  PInt(catalogIndexPageSize),
+		Fields: PString(catalogIndexFields),
+	}
+	if !updatedAt.IsZero() {
+		options.UpdatedAtMin = &updatedAt
+	}
+
+	var listOptions interface{} = options
+	for listOptions != nil {
+		page, pagination, err := products.ListWithPagination(listOptions)
+		if err != nil {
+			return err
+		}
+
+		ix.mu.Lock()
+		for _, product := range page {
+			ix.setProduct(product)
+			if product.UpdatedAt != nil && product.UpdatedAt.After(updatedAt) {
+				updatedAt = *product.UpdatedAt
+			}
+		}
+		ix.mu.Unlock()
+
+		listOptions = nil
+		if pagination != nil && pagination.NextPageOptions != nil {
+			next := *pagination.NextPageOptions
+			next.Fields = PString(catalogIndexFields)
+			listOptions = next
+		}
+	}
+
+	ix.mu.Lock()
+	ix.updatedAt = updatedAt
+	ix.mu.Unlock()
+	return nil
+}
+
+// Rebuild empties the index and refreshes it with all the products.
+func (ix *CatalogIndex) Rebuild(products ProductService) error {
+	fresh := NewCatalogIndex()
+	if err := fresh.Refresh(products); err != nil {
+		return err
+	}
+
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+	ix.updatedAt = fresh.updatedAt
+	ix.variants = fresh.variants
+	ix.products = fresh.products
+	ix.bySKU = fresh.bySKU
+	ix.byBarcode = fresh.byBarcode
+	return nil
+}
+
+// LookupSKU returns the variants with a SKU, sorted by variant ID. More than
+// one is a conflict.
+func (ix *CatalogIndex) LookupSKU(sku string) []CatalogEntry {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+	return ix.entries(ix.bySKU[sku])
+}
+
+// LookupBarcode returns the variants with a barcode, sorted by variant ID.
+// More than one is a conflict.
+func (ix *CatalogIndex) LookupBarcode(barcode string) []CatalogEntry {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+	return ix.entries(ix.byBarcode[barcode])
+}
+
+// Conflicts returns the SKUs and barcodes shared by several variants, sorted
+// by field and value.
+func (ix *CatalogIndex) Conflicts() []CatalogConflict {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	var conflicts []CatalogConflict
+	for _, index := range []struct {
+		field string
+		ids   map[string]map[int64]bool
+	}{{"barcode", ix.byBarcode}, {"sku", ix.bySKU}} {
+		var values []string
+		for value, ids := range index.ids {
+			if len(ids) > 1 {
+				values = append(values, value)
+			}
+		}
+		sort.Strings(values)
+
+		for _, value := range values {
+			conflicts = append(conflicts, CatalogConflict{
+				Field:   index.field,
+				Value:   value,
+				Entries: ix.entries(index.ids[value]),
+			})
+		}
+	}
+	return conflicts
+}
+
+// Len returns the number of variants in the index.
+func (ix *CatalogIndex) Len() int {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+	return len(ix.variants)
+}
+
+func (ix *CatalogIndex) reset() {
+	ix.variants = map[int64]CatalogEntry{}
+	ix.products = map[int64][]int64{}
+	ix.bySKU = map[string]map[int64]bool{}
+	ix.byBarcode = map[string]map[int64]bool{}
+}
+
+// setProduct replaces the variants of a product
+func (ix *CatalogIndex) setProduct(product Product) {
+	for _, variantID := range ix.products[product.ID] {
+		if ix.variants[variantID].ProductID == product.ID {
+			ix.remove(variantID)
+		}
+	}
+	delete(ix.products, product.ID)
+
+	for _, variant := range product.Variants {
+		ix.remove(variant.ID)
+		ix.add(CatalogEntry{
+			SKU:             variant.Sku,
+			Barcode:         variant.Barcode,
+			ProductID:       product.ID,
+			VariantID:       variant.ID,
+			InventoryItemID: variant.InventoryItemId,
+		})
+	}
+}
+
+// add indexes an entry by variant, product, SKU and barcode
+func (ix *CatalogIndex) add(entry CatalogEntry) {
+	ix.variants[entry.VariantID] = entry
+	ix.products[entry.ProductID] = append(ix.products[entry.ProductID], entry.VariantID)
+	addCatalogKey(ix.bySKU, entry.SKU, entry.VariantID)
+	addCatalogKey(ix.byBarcode, entry.Barcode, entry.VariantID)
+}
+
+// remove drops a variant from the variant, SKU and barcode maps. The
+// product map is left to setProduct.
+func (ix *CatalogIndex) remove(variantID int64) {
+	entry, ok := ix.variants[variantID]
+	if !ok {
+		return
+	}
+	delete(ix.variants, variantID)
+	removeCatalogKey(ix.bySKU, entry.SKU, variantID)
+	removeCatalogKey(ix.byBarcode, entry.Barcode, variantID)
+}
+
+func addCatalogKey(index map[string]map[int64]bool, value string, variantID int64) {
+	if value == "" {
+		return
+	}
+	if index[value] == nil {
+		index[value] = map[int64]bool{}
+	}
+	index[value][variantID] = true
+}
+
+func removeCatalogKey(index map[string]map[int64]bool, value string, variantID int64) {
+	delete(index[value], variantID)
+	if len(index[value]) == 0 {
+		delete(index, value)
+	}
+}
+
+// entries returns the entries of the given variants sorted by variant ID
+func (ix *CatalogIndex) entries(ids map[int64]bool) []CatalogEntry {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	entries := make([]CatalogEntry, 0, len(ids))
+	for id := range ids {
+		entries = append(entries, ix.variants[id])
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].VariantID < entries[j].VariantID })
+	return entries
+}
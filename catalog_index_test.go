@@ -0,0 +1,225 @@
+package goshopify
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func registerCatalogIndexPages(t *testing.T) {
+	productsURL := fmt.Sprintf("https://"+testHost+"/%s/products.json", client.pathPrefix)
+
+	first := httpmock.NewStringResponse(200, `{"products": [
+		{"id": 1, "updated_at": "2024-03-01T10:00:00Z", "variants": [
+			{"id": 11, "sku": "RED-S", "barcode": "0001", "inventory_item_id": 111},
+			{"id": 12, "sku": "RED-M", "inventory_item_id": 112}
+		]}
+	]}`)
+	first.Header.Set("Link", `<http://valid.url?page_info=page2&limit=250>; rel="next"`)
+	httpmock.RegisterResponderWithQuery("GET", productsURL,
+		map[string]string{"limit": "250", "fields": catalogIndexFields},
+		httpmock.ResponderFromResponse(first))
+
+	httpmock.RegisterResponderWithQuery("GET", productsURL,
+		map[string]string{"page_info": "page2", "limit": "250", "fields": catalogIndexFields},
+		httpmock.NewStringResponder(200, `{"products": [
+			{"id": 2, "updated_at": "2024-03-02T10:00:00Z", "variants": [
+				{"id": 21, "sku": "RED-S", "barcode": "0002", "inventory_item_id": 121},
+				{"id": 22, "inventory_item_id": 122}
+			]}
+		]}`))
+}
+
+func TestCatalogIndexRefresh(t *testing.T) {
+	setup()
+	defer teardown()
+	registerCatalogIndexPages(t)
+
+	ix := NewCatalogIndex()
+	if err := ix.Refresh(client.Product); err != nil {
+		t.Fatalf("CatalogIndex.Refresh returned error: %v", err)
+	}
+
+	if ix.Len() != 4 {
+		t.Errorf("CatalogIndex.Len returned %d, expected 4", ix.Len())
+	}
+
+	expected := []CatalogEntry{{SKU: "RED-M", ProductID: 1, VariantID: 12, InventoryItemID: 112}}
+	if entries := ix.LookupSKU("RED-M"); !reflect.DeepEqual(entries, expected) {
+		t.Errorf("CatalogIndex.LookupSKU returned %+v, expected %+v", entries, expected)
+	}
+
+	expected = []CatalogEntry{{SKU: "RED-S", Barcode: "0002", ProductID: 2, VariantID: 21, InventoryItemID: 121}}
+	if entries := ix.LookupBarcode("0002"); !reflect.DeepEqual(entries, expected) {
+		t.Errorf("CatalogIndex.LookupBarcode returned %+v, expected %+v", entries, expected)
+	}
+
+	if entries := ix.LookupSKU(""); entries != nil {
+		t.Errorf("CatalogIndex.LookupSKU returned %+v for an empty SKU, expected nil", entries)
+	}
+
+	expectedConflicts := []CatalogConflict{{
+		Field: "sku",
+		Value: "RED-S",
+		Entries: []CatalogEntry{
+			{SKU: "RED-S", Barcode: "0001", ProductID: 1, VariantID: 11, InventoryItemID: 111},
+			{SKU: "RED-S", Barcode: "0002", ProductID: 2, VariantID: 21, InventoryItemID: 121},
+		},
+	}}
+	if conflicts := ix.Conflicts(); !reflect.DeepEqual(conflicts, expectedConflicts) {
+		t.Errorf("CatalogIndex.Conflicts returned %+v, expected %+v", conflicts, expectedConflicts)
+	}
+
+	// The second refresh only lists the products updated since the last one
+	httpmock.RegisterResponderWithQuery("GET",
+		fmt.Sprintf("https://"+testHost+"/%s/products.json", client.pathPrefix),
+		map[string]string{"limit": "250", "fields": catalogIndexFields, "updated_at_min": "2024-03-02T10:00:00Z"},
+		httpmock.NewStringResponder(200, `{"products": [
+			{"id": 2, "updated_at": "2024-03-03T10:00:00Z", "variants": [
+				{"id": 21, "sku": "BLUE-S", "barcode": "0002", "inventory_item_id": 121}
+			]}
+		]}`))
+
+	if err := ix.Refresh(client.Product); err != nil {
+		t.Fatalf("CatalogIndex.Refresh returned error: %v", err)
+	}
+
+	if ix.Len() != 3 {
+		t.Errorf("CatalogIndex.Len returned %d after refreshing, expected 3", ix.Len())
+	}
+
+	if entries := ix.LookupSKU("RED-S"); len(entries) != 1 || entries[0].VariantID != 11 {
+		t.Errorf("CatalogIndex.LookupSKU returned %+v after refreshing, expected variant 11", entries)
+	}
+
+	if conflicts := ix.Conflicts(); conflicts != nil {
+		t.Errorf("CatalogIndex.Conflicts returned %+v after refreshing, expected none", conflicts)
+	}
+
+	if !ix.updatedAt.Equal(time.Date(2024, 3, 3, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("CatalogIndex.updatedAt is %v, expected 2024-03-03T10:00:00Z", ix.updatedAt)
+	}
+}
+
+func TestCatalogIndexRefreshError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/products.json", client.pathPrefix),
+		httpmock.NewStringResponder(500, `{"errors": "boom"}`))
+
+	ix := NewCatalogIndex()
+	if err := ix.Refresh(client.Product); err == nil {
+		t.Error("CatalogIndex.Refresh expected an error")
+	}
+
+	if !ix.updatedAt.IsZero() {
+		t.Errorf("CatalogIndex.updatedAt is %v after a failed refresh, expected zero", ix.updatedAt)
+	}
+}
+
+func TestCatalogIndexRebuild(t *testing.T) {
+	setup()
+	defer teardown()
+	registerCatalogIndexPages(t)
+
+	ix := NewCatalogIndex()
+	ix.setProduct(Product{ID: 3, Variants: []Variant{{ID: 31, Sku: "GONE"}}})
+	ix.updatedAt = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	if err := ix.Rebuild(client.Product); err != nil {
+		t.Fatalf("CatalogIndex.Rebuild returned error: %v", err)
+	}
+
+	if entries := ix.LookupSKU("GONE"); entries != nil {
+		t.Errorf("CatalogIndex.LookupSKU returned %+v after rebuilding, expected nil", entries)
+	}
+
+	if ix.Len() != 4 {
+		t.Errorf("CatalogIndex.Len returned %d after rebuilding, expected 4", ix.Len())
+	}
+}
+
+func TestCatalogIndexSaveAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "catalog.json")
+
+	ix, err := LoadCatalogIndex(path)
+	if err != nil {
+		t.Fatalf("LoadCatalogIndex returned error for a missing file: %v", err)
+	}
+	if ix.Len() != 0 {
+		t.Errorf("LoadCatalogIndex returned %d entries for a missing file, expected 0", ix.Len())
+	}
+
+	ix.setProduct(Product{ID: 1, Variants: []Variant{
+		{ID: 11, Sku: "RED-S", Barcode: "0001", InventoryItemId: 111},
+		{ID: 12, Sku: "RED-S", InventoryItemId: 112},
+	}})
+	ix.updatedAt = time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	if err := ix.Save(path); err != nil {
+		t.Fatalf("CatalogIndex.Save returned error: %v", err)
+	}
+
+	files, _ := os.ReadDir(filepath.Dir(path))
+	if len(files) != 1 {
+		t.Errorf("CatalogIndex.Save left %d files, expected 1", len(files))
+	}
+
+	loaded, err := LoadCatalogIndex(path)
+	if err != nil {
+		t.Fatalf("LoadCatalogIndex returned error: %v", err)
+	}
+
+	if !loaded.updatedAt.Equal(ix.updatedAt) {
+		t.Errorf("LoadCatalogIndex returned updatedAt %v, expected %v", loaded.updatedAt, ix.updatedAt)
+	}
+
+	if !reflect.DeepEqual(loaded.Conflicts(), ix.Conflicts()) {
+		t.Errorf("LoadCatalogIndex returned conflicts %+v, expected %+v", loaded.Conflicts(), ix.Conflicts())
+	}
+
+	if !reflect.DeepEqual(loaded.LookupBarcode("0001"), ix.LookupBarcode("0001")) {
+		t.Errorf("LoadCatalogIndex returned %+v, expected %+v", loaded.LookupBarcode("0001"), ix.LookupBarcode("0001"))
+	}
+
+	// Variants of a loaded product are replaced when it is updated
+	loaded.setProduct(Product{ID: 1, Variants: []Variant{{ID: 11, Sku: "RED-S"}}})
+	if loaded.Len() != 1 {
+		t.Errorf("CatalogIndex.Len returned %d after replacing the product, expected 1", loaded.Len())
+	}
+
+	if err := os.WriteFile(path, []byte("{"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadCatalogIndex(path); err == nil {
+		t.Error("LoadCatalogIndex expected an error for an invalid file")
+	}
+}
+
+func TestCatalogIndexSetProduct(t *testing.T) {
+	ix := NewCatalogIndex()
+	ix.setProduct(Product{ID: 1, Variants: []Variant{{ID: 11, Sku: "RED-S"}, {ID: 12, Sku: "RED-M"}}})
+
+	// a variant moved to another product is only indexed under the new one
+	ix.setProduct(Product{ID: 2, Variants: []Variant{{ID: 12, Sku: "BLUE-M"}}})
+	ix.setProduct(Product{ID: 1, Variants: []Variant{{ID: 11, Sku: "RED-S"}}})
+
+	expected := []CatalogEntry{{SKU: "BLUE-M", ProductID: 2, VariantID: 12}}
+	if entries := ix.LookupSKU("BLUE-M"); !reflect.DeepEqual(entries, expected) {
+		t.Errorf("CatalogIndex.LookupSKU returned %+v, expected %+v", entries, expected)
+	}
+
+	if entries := ix.LookupSKU("RED-M"); entries != nil {
+		t.Errorf("CatalogIndex.LookupSKU returned %+v for a replaced SKU, expected nil", entries)
+	}
+
+	if ix.Len() != 2 {
+		t.Errorf("CatalogIndex.Len returned %d, expected 2", ix.Len())
+	}
+}
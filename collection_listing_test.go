@@ -0,0 +1,148 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func collectionListingTests(t *testing.T, collection CollectionListing) {
+	// Check that ID is assigned to the returned collection
+	var expectedInt int64 = 482865238
+	if collection.ID != expectedInt {
+		t.Errorf("CollectionListing.ID returned %+v, expected %+v", collection.ID, expectedInt)
+	}
+
+	if collection.Handle != "smart-ipods" || collection.SortOrder != "manual" {
+		t.Errorf("CollectionListing returned %+v, expected the manually sorted smart-ipods", collection)
+	}
+
+	if collection.Image == nil || collection.DefaultProductImage != nil {
+		t.Errorf("CollectionListing returned image %+v and default product image %+v, expected only an image", collection.Image, collection.DefaultProductImage)
+	}
+}
+
+func TestCollectionListingList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/collection_listings.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"collection_listings": [{"collection_id":1},{"collection_id":2}]}`))
+
+	collections, err := client.CollectionListing.List(nil)
+	if err != nil {
+		t.Errorf("CollectionListing.List returned error: %v", err)
+	}
+
+	expected := []CollectionListing{{ID: 1}, {ID: 2}}
+	if !reflect.DeepEqual(collections, expected) {
+		t.Errorf("CollectionListing.List returned %+v, expected %+v", collections, expected)
+	}
+}
+
+func TestCollectionListingListWithPagination(t *testing.T) {
+	setup()
+	defer teardown()
+
+	response := httpmock.NewStringResponse(200, `{"collection_listings": [{"collection_id":1}]}`)
+	response.Header.Set("Link", `<http://valid.url?page_info=foo&limit=1>; rel="next"`)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/collection_listings.json", client.pathPrefix),
+		httpmock.ResponderFromResponse(response))
+
+	collections, pagination, err := client.CollectionListing.ListWithPagination(ListOptions{Limit: PInt(1)})
+	if err != nil {
+		t.Errorf("CollectionListing.ListWithPagination returned error: %v", err)
+	}
+
+	expected := []CollectionListing{{ID: 1}}
+	if !reflect.DeepEqual(collections, expected) {
+		t.Errorf("CollectionListing.ListWithPagination returned %+v, expected %+v", collections, expected)
+	}
+
+	expectedPagination := &Pagination{
+		NextPageOptions: &ListOptions{PageInfo: PString("foo"), Limit: PInt(1)},
+	}
+	if !reflect.DeepEqual(pagination, expectedPagination) {
+		t.Errorf("CollectionListing.ListWithPagination returned pagination %+v, expected %+v", pagination, expectedPagination)
+	}
+}
+
+func TestCollectionListingGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/collection_listings/482865238.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("collection_listing.json")))
+
+	collection, err := client.CollectionListing.Get(482865238, nil)
+	if err != nil {
+		t.Errorf("CollectionListing.Get returned error: %v", err)
+	}
+
+	collectionListingTests(t, *collection)
+}
+
+func TestCollectionListingGetProductIDs(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/collection_listings/482865238/product_ids.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"product_ids": [1,2,3]}`))
+
+	productIDs, err := client.CollectionListing.GetProductIDs(482865238, nil)
+	if err != nil {
+		t.Errorf("CollectionListing.GetProductIDs returned error: %v", err)
+	}
+
+	expected := []int64{1, 2, 3}
+	if !reflect.DeepEqual(productIDs, expected) {
+		t.Errorf("CollectionListing.GetProductIDs returned %+v, expected %+v", productIDs, expected)
+	}
+}
+
+func TestCollectionListingPublish(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body []byte
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://"+testHost+"/%s/collection_listings/482865238.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			var err error
+			if body, err = io.ReadAll(req.Body); err != nil {
+				return nil, err
+			}
+			return httpmock.NewBytesResponse(200, loadFixture("collection_listing.json")), nil
+		})
+
+	collection, err := client.CollectionListing.Publish(482865238)
+	if err != nil {
+		t.Errorf("CollectionListing.Publish returned error: %v", err)
+	}
+
+	collectionListingTests(t, *collection)
+
+	var sent, expected interface{}
+	json.Unmarshal(body, &sent)
+	json.Unmarshal([]byte(`{"collection_listing": {"collection_id": 482865238}}`), &expected)
+	if !reflect.DeepEqual(sent, expected) {
+		t.Errorf("CollectionListing.Publish sent %s, expected %+v", body, expected)
+	}
+}
+
+func TestCollectionListingDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("https://"+testHost+"/%s/collection_listings/482865238.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.CollectionListing.Delete(482865238)
+	if err != nil {
+		t.Errorf("CollectionListing.Delete returned error: %v", err)
+	}
+}
@@ -0,0 +1,165 @@
+package goshopify
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const commentsBasePath = "comments"
+
+// CommentService is an interface for interfacing with the comment endpoints of
+// the Shopify API. Comments belong to the articles of a blog.
+// See: https://shopify.dev/docs/api/admin-rest/2023-01/resources/comment
+type CommentService interface {
+	List(interface{}) ([]Comment, error)
+	ListWithPagination(interface{}) ([]Comment, *Pagination, error)
+	Count(interface{}) (int, error)
+	Get(int64, interface{}) (*Comment, error)
+	Create(Comment) (*Comment, error)
+	Update(Comment) (*Comment, error)
+	Spam(int64) (*Comment, error)
+	NotSpam(int64) (*Comment, error)
+	Approve(int64) (*Comment, error)
+	Remove(int64) (*Comment, error)
+	Restore(int64) (*Comment, error)
+}
+
+// CommentServiceOp handles communication with the comment related methods of
+// the Shopify API.
+type CommentServiceOp struct {
+	client *Client
+}
+
+// Comment represents a comment on a Shopify blog article
+type Comment struct {
+	ID          int64      `json:"id,omitempty" bson:"id,omitempty"`
+	ArticleID   int64      `json:"article_id,omitempty" bson:"article_id,omitempty"`
+	BlogID      int64      `json:"blog_id,omitempty" bson:"blog_id,omitempty"`
+	Author      string     `json:"author,omitempty" bson:"author,omitempty"`
+	Email       string     `json:"email,omitempty" bson:"email,omitempty"`
+	Body        string     `json:"body,omitempty" bson:"body,omitempty"`
+	BodyHTML    string     `json:"body_html,omitempty" bson:"body_html,omitempty"`
+	Status      string     `json:"status,omitempty" bson:"status,omitempty"`
+	IP          string     `json:"ip,omitempty" bson:"ip,omitempty"`
+	UserAgent   string     `json:"user_agent,omitempty" bson:"user_agent,omitempty"`
+	PublishedAt *time.Time `json:"published_at,omitempty" bson:"published_at,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty" bson:"created_at,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty" bson:"updated_at,omitempty"`
+}
+
+// CommentListOptions represents the options available when listing comments
+type CommentListOptions struct {
+	ListOptions
+	BlogID    int64  `json:"blog_id,omitempty" url:"blog_id,omitempty"`
+	ArticleID int64  `json:"article_id,omitempty" url:"article_id,omitempty"`
+	Status    string `json:"status,omitempty" url:"status,omitempty"`
+}
+
+// CommentResource represents the result from the comments/X.json endpoint
+type CommentResource struct {
+	Comment *Comment `json:"comment" bson:"comment"`
+}
+
+// CommentsResource represents the result from the comments.json endpoint
+type CommentsResource struct {
+	Comments []Comment `json:"comments" bson:"comments"`
+}
+
+// List comments
+func (s *CommentServiceOp) List(options interface{}) ([]Comment, error) {
+	comments, _, err := s.ListWithPagination(options)
+	if err != nil {
+		return nil, err
+	}
+	return comments, nil
+}
+
+// ListWithPagination lists comments and return pagination to retrieve next/previous results.
+func (s *CommentServiceOp) ListWithPagination(options interface{}) ([]Comment, *Pagination, error) {
+	path := fmt.Sprintf("%s.json", commentsBasePath)
+	resource := new(CommentsResource)
+	headers := http.Header{}
+
+	headers, err := s.client.createAndDoGetHeaders("GET", path, nil, options, resource)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Extract pagination info from header
+	linkHeader := headers.Get("Link")
+
+	pagination, err := extractPagination(linkHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource.Comments, pagination, nil
+}
+
+// Count comments
+func (s *CommentServiceOp) Count(options interface{}) (int, error) {
+	path := fmt.Sprintf("%s/count.json", commentsBasePath)
+	return s.client.Count(path, options)
+}
+
+// Get individual comment
+func (s *CommentServiceOp) Get(commentID int64, options interface{}) (*Comment, error) {
+	path := fmt.Sprintf("%s/%d.json", commentsBasePath, commentID)
+	resource := new(CommentResource)
+	err := s.client.Get(path, resource, options)
+	return resource.Comment, err
+}
+
+// Create a new comment on an article, set with ArticleID and BlogID
+func (s *CommentServiceOp) Create(comment Comment) (*Comment, error) {
+	path := fmt.Sprintf("%s.json", commentsBasePath)
+	wrappedData := CommentResource{Comment: &comment}
+	resource := new(CommentResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.Comment, err
+}
+
+// Update an existing comment
+func (s *CommentServiceOp) Update(comment Comment) (*Comment, error) {
+	path := fmt.Sprintf("%s/%d.json", commentsBasePath, comment.ID)
+	wrappedData := CommentResource{Comment: &comment}
+	resource := new(CommentResource)
+	err := s.client.Put(path, wrappedData, resource)
+	return resource.Comment, err
+}
+
+// Spam marks a comment as spam
+func (s *CommentServiceOp) Spam(commentID int64) (*Comment, error) {
+	return s.moderate(commentID, "spam")
+}
+
+// NotSpam marks a comment as not spam, restoring it to the published or
+// unapproved status
+func (s *CommentServiceOp) NotSpam(commentID int64) (*Comment, error) {
+	return s.moderate(commentID, "not_spam")
+}
+
+// Approve publishes a comment
+func (s *CommentServiceOp) Approve(commentID int64) (*Comment, error) {
+	return s.moderate(commentID, "approve")
+}
+
+// Remove hides a comment
+func (s *CommentServiceOp) Remove(commentID int64) (*Comment, error) {
+	return s.moderate(commentID, "remove")
+}
+
+// Restore a removed comment
+func (s *CommentServiceOp) Restore(commentID int64) (*Comment, error) {
+	return s.moderate(commentID, "restore")
+}
+
+// moderate performs a moderation action on a comment. Unlike the other
+// endpoints, the comment isn't wrapped in the response.
+func (s *CommentServiceOp) moderate(commentID int64, action string) (*Comment, error) {
+	path := fmt.Sprintf("%s/%d/%s.json", commentsBasePath, commentID, action)
+	resource := new(Comment)
+	err := s.client.Post(path, nil, resource)
+	return resource, err
+}
@@ -0,0 +1,188 @@
+package goshopify
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func commentTests(t *testing.T, comment Comment) {
+	// Check that ID is assigned to the returned comment
+	expectedID := int64(653537639)
+	if comment.ID != expectedID {
+		t.Errorf("Comment.ID returned %+v, expected %+v", comment.ID, expectedID)
+	}
+
+	// Check that the ArticleID and BlogID values are assigned to the returned comment
+	if comment.ArticleID != 134645308 || comment.BlogID != 241253187 {
+		t.Errorf("Comment returned article %d of blog %d, expected article 134645308 of blog 241253187", comment.ArticleID, comment.BlogID)
+	}
+
+	expectedAuthor := "Soleone"
+	if comment.Author != expectedAuthor {
+		t.Errorf("Comment.Author returned %+v, expected %+v", comment.Author, expectedAuthor)
+	}
+}
+
+func TestCommentList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponderWithQuery("GET", fmt.Sprintf("https://"+testHost+"/%s/comments.json", client.pathPrefix),
+		map[string]string{"article_id": "134645308", "blog_id": "241253187", "limit": "5"},
+		httpmock.NewBytesResponder(200, loadFixture("comments.json")))
+
+	options := CommentListOptions{
+		ListOptions: ListOptions{Limit: PInt(5)},
+		BlogID:      241253187,
+		ArticleID:   134645308,
+	}
+	comments, err := client.Comment.List(options)
+	if err != nil {
+		t.Errorf("Comment.List returned error: %v", err)
+	}
+
+	if len(comments) != 1 {
+		t.Fatalf("Comment.List got %d comments, expected 1", len(comments))
+	}
+	commentTests(t, comments[0])
+}
+
+func TestCommentListWithPagination(t *testing.T) {
+	setup()
+	defer teardown()
+
+	response := httpmock.NewBytesResponse(200, loadFixture("comments.json"))
+	response.Header.Set("Link", `<http://valid.url?page_info=foo&limit=1>; rel="next"`)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/comments.json", client.pathPrefix),
+		httpmock.ResponderFromResponse(response))
+
+	comments, pagination, err := client.Comment.ListWithPagination(nil)
+	if err != nil {
+		t.Errorf("Comment.ListWithPagination returned error: %v", err)
+	}
+
+	if len(comments) != 1 {
+		t.Fatalf("Comment.ListWithPagination got %d comments, expected 1", len(comments))
+	}
+
+	expectedPagination := &Pagination{
+		NextPageOptions: &ListOptions{PageInfo: PString("foo"), Limit: PInt(1)},
+	}
+	if !reflect.DeepEqual(pagination, expectedPagination) {
+		t.Errorf("Comment.ListWithPagination returned pagination %+v, expected %+v", pagination, expectedPagination)
+	}
+}
+
+func TestCommentCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/comments/count.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"count": 2}`))
+
+	cnt, err := client.Comment.Count(nil)
+	if err != nil {
+		t.Errorf("Comment.Count returned error: %v", err)
+	}
+
+	expected := 2
+	if cnt != expected {
+		t.Errorf("Comment.Count returned %d, expected %d", cnt, expected)
+	}
+}
+
+func TestCommentGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/comments/653537639.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("comment.json")))
+
+	comment, err := client.Comment.Get(653537639, nil)
+	if err != nil {
+		t.Errorf("Comment.Get returned error: %v", err)
+	}
+
+	commentTests(t, *comment)
+}
+
+func TestCommentCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://"+testHost+"/%s/comments.json", client.pathPrefix),
+		httpmock.NewBytesResponder(201, loadFixture("comment.json")))
+
+	comment := Comment{
+		Body:      "Hi author, I really _like_ what you're doing there.",
+		Author:    "Soleone",
+		Email:     "sole@one.de",
+		IP:        "127.0.0.1",
+		BlogID:    241253187,
+		ArticleID: 134645308,
+	}
+
+	returnedComment, err := client.Comment.Create(comment)
+	if err != nil {
+		t.Errorf("Comment.Create returned error: %v", err)
+	}
+
+	commentTests(t, *returnedComment)
+}
+
+func TestCommentUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://"+testHost+"/%s/comments/653537639.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("comment.json")))
+
+	comment := Comment{
+		ID:     653537639,
+		Body:   "You can even update through a web service.",
+		Author: "Soleone",
+	}
+
+	returnedComment, err := client.Comment.Update(comment)
+	if err != nil {
+		t.Errorf("Comment.Update returned error: %v", err)
+	}
+
+	commentTests(t, *returnedComment)
+}
+
+func TestCommentModeration(t *testing.T) {
+	setup()
+	defer teardown()
+
+	cases := []struct {
+		action string
+		call   func(int64) (*Comment, error)
+		status string
+	}{
+		{"spam", client.Comment.Spam, "spam"},
+		{"not_spam", client.Comment.NotSpam, "published"},
+		{"approve", client.Comment.Approve, "published"},
+		{"remove", client.Comment.Remove, "removed"},
+		{"restore", client.Comment.Restore, "published"},
+	}
+
+	for _, c := range cases {
+		httpmock.RegisterResponder("POST", fmt.Sprintf("https://"+testHost+"/%s/comments/653537639/%s.json", client.pathPrefix, c.action),
+			httpmock.NewStringResponder(201, fmt.Sprintf(`{"id":653537639,"article_id":134645308,"blog_id":241253187,"author":"Soleone","status":"%s"}`, c.status)))
+
+		comment, err := c.call(653537639)
+		if err != nil {
+			t.Errorf("Comment %s returned error: %v", c.action, err)
+			continue
+		}
+		commentTests(t, *comment)
+
+		if comment.Status != c.status {
+			t.Errorf("Comment %s returned status %s, expected %s", c.action, comment.Status, c.status)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package goshopify
+
+import "fmt"
+
+const countriesBasePath = "countries"
+
+// CountryService is an interface for interfacing with the country endpoints
+// of the Shopify API.
+// See: https://shopify.dev/docs/api/admin-rest/2023-01/resources/country
+type CountryService interface {
+	List(interface{}) ([]Country, error)
+	Count(interface{}) (int, error)
+	Get(int64, interface{}) (*Country, error)
+	Create(Country) (*Country, error)
+	Update(Country) (*Country, error)
+	Delete(int64) error
+}
+
+// CountryServiceOp handles communication with the country related methods of
+// the Shopify API.
+type CountryServiceOp struct {
+	client *Client
+}
+
+// Country represents a country the store ships to, with its tax rates. It
+// shares its fields with the countries of shipping zones.
+type Country = ShippingCountry
+
+// CountryResource represents the result from the countries/X.json endpoint
+type CountryResource struct {
+	Country *Country `json:"country" bson:"country"`
+}
+
+// CountriesResource represents the result from the countries.json endpoint
+type CountriesResource struct {
+	Countries []Country `json:"countries" bson:"countries"`
+}
+
+// List countries
+func (s *CountryServiceOp) List(options interface{}) ([]Country, error) {
+	path := fmt.Sprintf("%s.json", countriesBasePath)
+	resource := new(CountriesResource)
+	err := s.client.getReference(path, resource, options)
+	return resource.Countries, err
+}
+
+// Count countries
+func (s *CountryServiceOp) Count(options interface{}) (int, error) {
+	path := fmt.Sprintf("%s/count.json", countriesBasePath)
+	return s.client.Count(path, options)
+}
+
+// Get individual country
+func (s *CountryServiceOp) Get(countryID int64, options interface{}) (*Country, error) {
+	path := fmt.Sprintf("%s/%d.json", countriesBasePath, countryID)
+	resource := new(CountryResource)
+	err := s.client.getReference(path, resource, options)
+	return resource.Country, err
+}
+
+// Create a new country, by Code. Its tax rate defaults to Shopify's one when
+// Tax isn't set.
+func (s *CountryServiceOp) Create(country Country) (*Country, error) {
+	path := fmt.Sprintf("%s.json", countriesBasePath)
+	wrappedData := CountryResource{Country: &country}
+	resource := new(CountryResource)
+	err := s.client.Post(path, wrappedData, resource)
+	s.client.PurgeReferenceCache()
+	return resource.Country, err
+}
+
+// Update the tax rate of an existing country
+func (s *CountryServiceOp) Update(country Country) (*Country, error) {
+	path := fmt.Sprintf("%s/%d.json", countriesBasePath, country.ID)
+	wrappedData := CountryResource{Country: &country}
+	resource := new(CountryResource)
+	err := s.client.Put(path, wrappedData, resource)
+	s.client.PurgeReferenceCache()
+	return resource.Country, err
+}
+
+// Delete an existing country
+func (s *CountryServiceOp) Delete(countryID int64) error {
+	err := s.client.Delete(fmt.Sprintf("%s/%d.json", countriesBasePath, countryID))
+	s.client.PurgeReferenceCache()
+	return err
+}
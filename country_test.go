@@ -0,0 +1,122 @@
+package goshopify
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func countryTests(t *testing.T, country Country) {
+	// Check that ID is assigned to the returned country
+	expectedID := int64(879921427)
+	if country.ID != expectedID {
+		t.Errorf("Country.ID returned %+v, expected %+v", country.ID, expectedID)
+	}
+
+	expectedTax := decimal.NewFromFloat(0.05)
+	if country.Tax == nil || !country.Tax.Equal(expectedTax) {
+		t.Errorf("Country.Tax returned %v, expected %v", country.Tax, expectedTax)
+	}
+
+	if len(country.Provinces) != 1 {
+		t.Fatalf("Country.Provinces got %d provinces, expected 1", len(country.Provinces))
+	}
+	provinceTests(t, country.Provinces[0])
+}
+
+func TestCountryList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/countries.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("countries.json")))
+
+	countries, err := client.Country.List(nil)
+	if err != nil {
+		t.Errorf("Country.List returned error: %v", err)
+	}
+
+	if len(countries) != 1 {
+		t.Fatalf("Country.List got %d countries, expected 1", len(countries))
+	}
+	countryTests(t, countries[0])
+}
+
+func TestCountryCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/countries/count.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"count": 5}`))
+
+	cnt, err := client.Country.Count(nil)
+	if err != nil {
+		t.Errorf("Country.Count returned error: %v", err)
+	}
+
+	expected := 5
+	if cnt != expected {
+		t.Errorf("Country.Count returned %d, expected %d", cnt, expected)
+	}
+}
+
+func TestCountryGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/countries/879921427.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("country.json")))
+
+	country, err := client.Country.Get(879921427, nil)
+	if err != nil {
+		t.Errorf("Country.Get returned error: %v", err)
+	}
+
+	countryTests(t, *country)
+}
+
+func TestCountryCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://"+testHost+"/%s/countries.json", client.pathPrefix),
+		httpmock.NewBytesResponder(201, loadFixture("country.json")))
+
+	country, err := client.Country.Create(Country{Code: "CA"})
+	if err != nil {
+		t.Errorf("Country.Create returned error: %v", err)
+	}
+
+	countryTests(t, *country)
+}
+
+func TestCountryUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://"+testHost+"/%s/countries/879921427.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("country.json")))
+
+	tax := decimal.NewFromFloat(0.05)
+	country, err := client.Country.Update(Country{ID: 879921427, Tax: &tax})
+	if err != nil {
+		t.Errorf("Country.Update returned error: %v", err)
+	}
+
+	countryTests(t, *country)
+}
+
+func TestCountryDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("https://"+testHost+"/%s/countries/879921427.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.Country.Delete(879921427)
+	if err != nil {
+		t.Errorf("Country.Delete returned error: %v", err)
+	}
+}
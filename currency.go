@@ -0,0 +1,36 @@
+package goshopify
+
+import "time"
+
+// CurrencyService is an interface for interfacing with the currency endpoint
+// of the Shopify API.
+// See: https://shopify.dev/docs/api/admin-rest/2023-01/resources/currency
+type CurrencyService interface {
+	List() ([]Currency, error)
+}
+
+// CurrencyServiceOp handles communication with the currency related methods
+// of the Shopify API.
+type CurrencyServiceOp struct {
+	client *Client
+}
+
+// Currency represents a currency enabled on the store, in addition to its
+// default currency
+type Currency struct {
+	Currency      string     `json:"currency,omitempty" bson:"currency,omitempty"`
+	RateUpdatedAt *time.Time `json:"rate_updated_at,omitempty" bson:"rate_updated_at,omitempty"`
+	Enabled       bool       `json:"enabled" bson:"enabled"`
+}
+
+// CurrenciesResource represents the result from the currencies.json endpoint
+type CurrenciesResource struct {
+	Currencies []Currency `json:"currencies" bson:"currencies"`
+}
+
+// List currencies
+func (s *CurrencyServiceOp) List() ([]Currency, error) {
+	resource := new(CurrenciesResource)
+	err := s.client.getReference("currencies.json", resource, nil)
+	return resource.Currencies, err
+}
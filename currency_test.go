@@ -0,0 +1,38 @@
+package goshopify
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func TestCurrencyList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/currencies.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("currencies.json")))
+
+	currencies, err := client.Currency.List()
+	if err != nil {
+		t.Errorf("Currency.List returned error: %v", err)
+	}
+
+	if len(currencies) != 2 {
+		t.Fatalf("Currency.List got %d currencies, expected 2", len(currencies))
+	}
+
+	expectedUpdatedAt := time.Date(2018, time.January, 24, 0, 1, 1, 0, time.UTC)
+	currency := currencies[0]
+	if currency.Currency != "CAD" || !currency.Enabled {
+		t.Errorf("Currency.List returned %+v, expected enabled CAD", currency)
+	}
+	if currency.RateUpdatedAt == nil || !currency.RateUpdatedAt.Equal(expectedUpdatedAt) {
+		t.Errorf("Currency.RateUpdatedAt returned %v, expected %v", currency.RateUpdatedAt, expectedUpdatedAt)
+	}
+	if currencies[1].Enabled {
+		t.Errorf("Currency.List returned %+v, expected disabled EUR", currencies[1])
+	}
+}
@@ -23,6 +23,8 @@ type CustomerService interface {
 	Delete(int64) error
 	ListOrders(int64, interface{}) ([]Order, error)
 	ListTags(interface{}) ([]string, error)
+	SendInvite(int64, CustomerInvite) (*CustomerInvite, error)
+	GetAccountActivationURL(int64) (string, error)
 
 	// MetafieldsService used for Customer resource to communicate with Metafields resource
 	MetafieldsService
@@ -107,6 +109,26 @@ type CustomerTagsResource struct {
 	Tags []string `json:"tags" bson:"tags"`
 }
 
+// CustomerInvite represents the email inviting a customer to create their
+// account. Fields left empty default to the shop's invite email.
+type CustomerInvite struct {
+	To            string   `json:"to,omitempty" bson:"to,omitempty"`
+	From          string   `json:"from,omitempty" bson:"from,omitempty"`
+	Bcc           []string `json:"bcc,omitempty" bson:"bcc,omitempty"`
+	Subject       string   `json:"subject,omitempty" bson:"subject,omitempty"`
+	CustomMessage string   `json:"custom_message,omitempty" bson:"custom_message,omitempty"`
+}
+
+// Represents the result from the customers/X/send_invite.json endpoint
+type CustomerInviteResource struct {
+	CustomerInvite *CustomerInvite `json:"customer_invite" bson:"customer_invite"`
+}
+
+// Represents the result from the customers/X/account_activation_url.json endpoint
+type CustomerAccountActivationURLResource struct {
+	AccountActivationURL string `json:"account_activation_url" bson:"account_activation_url"`
+}
+
 // Represents the options available when searching for a customer
 type CustomerSearchOptions struct {
 	Page   int    `url:"page,omitempty" bson:"page,omitempty"`
@@ -186,6 +208,26 @@ func (s *CustomerServiceOp) ListTags(options interface{}) ([]string, error) {
 	return resource.Tags, err
 }
 
+// SendInvite sends an invite to create their account to a customer
+func (s *CustomerServiceOp) SendInvite(customerID int64, invite CustomerInvite) (*CustomerInvite, error) {
+	path := fmt.Sprintf("%s/%d/send_invite.json", customersBasePath, customerID)
+	wrappedData := CustomerInviteResource{CustomerInvite: &invite}
+	resource := new(CustomerInviteResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.CustomerInvite, err
+}
+
+// GetAccountActivationURL generates a one-time URL for a customer to activate
+// their account, which expires after 30 days. Generating a new one
+// invalidates the previous URL. The URL is masked in logged bodies by every
+// Redactor, as it lets anyone activate the account.
+func (s *CustomerServiceOp) GetAccountActivationURL(customerID int64) (string, error) {
+	path := fmt.Sprintf("%s/%d/account_activation_url.json", customersBasePath, customerID)
+	resource := new(CustomerAccountActivationURLResource)
+	err := s.client.Post(path, nil, resource)
+	return resource.AccountActivationURL, err
+}
+
 // List metafields for a customer
 func (s *CustomerServiceOp) ListMetafields(customerID int64, options interface{}) ([]Metafield, error) {
 	metafieldService := &MetafieldServiceOp{client: s.client, resource: customersResourceName, resourceID: customerID}
@@ -0,0 +1,100 @@
+package goshopify
+
+import (
+	"fmt"
+	"time"
+)
+
+const customerSavedSearchesBasePath = "customer_saved_searches"
+
+// CustomerSavedSearchService is an interface for interfacing with the customer
+// saved search endpoints of the Shopify API.
+// See: https://shopify.dev/docs/api/admin-rest/2023-01/resources/customersavedsearch
+type CustomerSavedSearchService interface {
+	List(interface{}) ([]CustomerSavedSearch, error)
+	Count(interface{}) (int, error)
+	Get(int64, interface{}) (*CustomerSavedSearch, error)
+	Create(CustomerSavedSearch) (*CustomerSavedSearch, error)
+	Update(CustomerSavedSearch) (*CustomerSavedSearch, error)
+	Delete(int64) error
+	ListCustomers(int64, interface{}) ([]Customer, error)
+}
+
+// CustomerSavedSearchServiceOp handles communication with the customer saved
+// search related methods of the Shopify API.
+type CustomerSavedSearchServiceOp struct {
+	client *Client
+}
+
+// CustomerSavedSearch represents a named search for customers, e.g.
+// "accepts_marketing:1 country:Canada"
+type CustomerSavedSearch struct {
+	ID        int64      `json:"id,omitempty" bson:"id,omitempty"`
+	Name      string     `json:"name,omitempty" bson:"name,omitempty"`
+	Query     string     `json:"query,omitempty" bson:"query,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty" bson:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty" bson:"updated_at,omitempty"`
+}
+
+// CustomerSavedSearchResource represents the result from the customer_saved_searches/X.json endpoint
+type CustomerSavedSearchResource struct {
+	CustomerSavedSearch *CustomerSavedSearch `json:"customer_saved_search" bson:"customer_saved_search"`
+}
+
+// CustomerSavedSearchesResource represents the result from the customer_saved_searches.json endpoint
+type CustomerSavedSearchesResource struct {
+	CustomerSavedSearches []CustomerSavedSearch `json:"customer_saved_searches" bson:"customer_saved_searches"`
+}
+
+// List customer saved searches
+func (s *CustomerSavedSearchServiceOp) List(options interface{}) ([]CustomerSavedSearch, error) {
+	path := fmt.Sprintf("%s.json", customerSavedSearchesBasePath)
+	resource := new(CustomerSavedSearchesResource)
+	err := s.client.Get(path, resource, options)
+	return resource.CustomerSavedSearches, err
+}
+
+// Count customer saved searches
+func (s *CustomerSavedSearchServiceOp) Count(options interface{}) (int, error) {
+	path := fmt.Sprintf("%s/count.json", customerSavedSearchesBasePath)
+	return s.client.Count(path, options)
+}
+
+// Get individual customer saved search
+func (s *CustomerSavedSearchServiceOp) Get(searchID int64, options interface{}) (*CustomerSavedSearch, error) {
+	path := fmt.Sprintf("%s/%d.json", customerSavedSearchesBasePath, searchID)
+	resource := new(CustomerSavedSearchResource)
+	err := s.client.Get(path, resource, options)
+	return resource.CustomerSavedSearch, err
+}
+
+// Create a new customer saved search
+func (s *CustomerSavedSearchServiceOp) Create(search CustomerSavedSearch) (*CustomerSavedSearch, error) {
+	path := fmt.Sprintf("%s.json", customerSavedSearchesBasePath)
+	wrappedData := CustomerSavedSearchResource{CustomerSavedSearch: &search}
+	resource := new(CustomerSavedSearchResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.CustomerSavedSearch, err
+}
+
+// Update an existing customer saved search
+func (s *CustomerSavedSearchServiceOp) Update(search CustomerSavedSearch) (*CustomerSavedSearch, error) {
+	path := fmt.Sprintf("%s/%d.json", customerSavedSearchesBasePath, search.ID)
+	wrappedData := CustomerSavedSearchResource{CustomerSavedSearch: &search}
+	resource := new(CustomerSavedSearchResource)
+	err := s.client.Put(path, wrappedData, resource)
+	return resource.CustomerSavedSearch, err
+}
+
+// Delete an existing customer saved search
+func (s *CustomerSavedSearchServiceOp) Delete(searchID int64) error {
+	return s.client.Delete(fmt.Sprintf("%s/%d.json", customerSavedSearchesBasePath, searchID))
+}
+
+// ListCustomers lists the customers matching a customer saved search
+func (s *CustomerSavedSearchServiceOp) ListCustomers(searchID int64, options interface{}) ([]Customer, error) {
+	path := fmt.Sprintf("%s/%d/%s.json", customerSavedSearchesBasePath, searchID, customersResourceName)
+	resource := new(CustomersResource)
+	err := s.client.Get(path, resource, options)
+	return resource.Customers, err
+}
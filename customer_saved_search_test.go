@@ -0,0 +1,140 @@
+package goshopify
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func customerSavedSearchTests(t *testing.T, search CustomerSavedSearch) {
+	// Check that ID is assigned to the returned search
+	expectedID := int64(789629109)
+	if search.ID != expectedID {
+		t.Errorf("CustomerSavedSearch.ID returned %+v, expected %+v", search.ID, expectedID)
+	}
+
+	if search.Name != "Accepts Marketing" || search.Query != "accepts_marketing:1" {
+		t.Errorf("CustomerSavedSearch returned %+v, expected the Accepts Marketing search", search)
+	}
+
+	if search.CreatedAt == nil {
+		t.Errorf("CustomerSavedSearch.CreatedAt returned nil, expected a time")
+	}
+}
+
+func TestCustomerSavedSearchList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/customer_saved_searches.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("customer_saved_searches.json")))
+
+	searches, err := client.CustomerSavedSearch.List(nil)
+	if err != nil {
+		t.Errorf("CustomerSavedSearch.List returned error: %v", err)
+	}
+
+	if len(searches) != 1 {
+		t.Fatalf("CustomerSavedSearch.List got %d searches, expected 1", len(searches))
+	}
+	customerSavedSearchTests(t, searches[0])
+}
+
+func TestCustomerSavedSearchCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/customer_saved_searches/count.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"count": 3}`))
+
+	cnt, err := client.CustomerSavedSearch.Count(nil)
+	if err != nil {
+		t.Errorf("CustomerSavedSearch.Count returned error: %v", err)
+	}
+
+	expected := 3
+	if cnt != expected {
+		t.Errorf("CustomerSavedSearch.Count returned %d, expected %d", cnt, expected)
+	}
+}
+
+func TestCustomerSavedSearchGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/customer_saved_searches/789629109.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("customer_saved_search.json")))
+
+	search, err := client.CustomerSavedSearch.Get(789629109, nil)
+	if err != nil {
+		t.Errorf("CustomerSavedSearch.Get returned error: %v", err)
+	}
+
+	customerSavedSearchTests(t, *search)
+}
+
+func TestCustomerSavedSearchCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://"+testHost+"/%s/customer_saved_searches.json", client.pathPrefix),
+		httpmock.NewBytesResponder(201, loadFixture("customer_saved_search.json")))
+
+	search := CustomerSavedSearch{Name: "Accepts Marketing", Query: "accepts_marketing:1"}
+	returnedSearch, err := client.CustomerSavedSearch.Create(search)
+	if err != nil {
+		t.Errorf("CustomerSavedSearch.Create returned error: %v", err)
+	}
+
+	customerSavedSearchTests(t, *returnedSearch)
+}
+
+func TestCustomerSavedSearchUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://"+testHost+"/%s/customer_saved_searches/789629109.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("customer_saved_search.json")))
+
+	search := CustomerSavedSearch{ID: 789629109, Name: "Accepts Marketing"}
+	returnedSearch, err := client.CustomerSavedSearch.Update(search)
+	if err != nil {
+		t.Errorf("CustomerSavedSearch.Update returned error: %v", err)
+	}
+
+	customerSavedSearchTests(t, *returnedSearch)
+}
+
+func TestCustomerSavedSearchDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("https://"+testHost+"/%s/customer_saved_searches/789629109.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.CustomerSavedSearch.Delete(789629109)
+	if err != nil {
+		t.Errorf("CustomerSavedSearch.Delete returned error: %v", err)
+	}
+}
+
+func TestCustomerSavedSearchListCustomers(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponderWithQuery("GET", fmt.Sprintf("https://"+testHost+"/%s/customer_saved_searches/789629109/customers.json", client.pathPrefix),
+		map[string]string{"limit": "2"},
+		httpmock.NewStringResponder(200, `{"customers": [{"id":1},{"id":2}]}`))
+
+	customers, err := client.CustomerSavedSearch.ListCustomers(789629109, CustomerSearchOptions{Limit: 2})
+	if err != nil {
+		t.Errorf("CustomerSavedSearch.ListCustomers returned error: %v", err)
+	}
+
+	expected := []Customer{{ID: 1}, {ID: 2}}
+	if !reflect.DeepEqual(customers, expected) {
+		t.Errorf("CustomerSavedSearch.ListCustomers returned %+v, expected %+v", customers, expected)
+	}
+}
@@ -1,7 +1,9 @@
 package goshopify
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"reflect"
 	"testing"
 	"time"
@@ -487,3 +489,60 @@ func TestCustomerListTags(t *testing.T) {
 		t.Errorf("Customer.ListTags got %v as the first tag, expected: 'tag1'", tags[0])
 	}
 }
+
+func TestCustomerSendInvite(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var sent map[string]CustomerInvite
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://"+testHost+"/%s/customers/1/send_invite.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&sent); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(201, `{"customer_invite": {"to": "new_test_email@shopify.com", "from": "j.limited@example.com", "subject": "Welcome to my new shop", "custom_message": "My awesome new store", "bcc": []}}`), nil
+		})
+
+	invite := CustomerInvite{
+		To:            "new_test_email@shopify.com",
+		Subject:       "Welcome to my new shop",
+		CustomMessage: "My awesome new store",
+	}
+	returnedInvite, err := client.Customer.SendInvite(1, invite)
+	if err != nil {
+		t.Fatalf("Customer.SendInvite returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(sent["customer_invite"], invite) {
+		t.Errorf("Customer.SendInvite sent %+v, expected %+v", sent["customer_invite"], invite)
+	}
+
+	expected := &CustomerInvite{
+		To:            "new_test_email@shopify.com",
+		From:          "j.limited@example.com",
+		Bcc:           []string{},
+		Subject:       "Welcome to my new shop",
+		CustomMessage: "My awesome new store",
+	}
+	if !reflect.DeepEqual(returnedInvite, expected) {
+		t.Errorf("Customer.SendInvite returned %+v, expected %+v", returnedInvite, expected)
+	}
+}
+
+func TestCustomerGetAccountActivationURL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://"+testHost+"/%s/customers/1/account_activation_url.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"account_activation_url": "https://jsmith.myshopify.com/account/activate/1/a1b2c3"}`))
+
+	url, err := client.Customer.GetAccountActivationURL(1)
+	if err != nil {
+		t.Errorf("Customer.GetAccountActivationURL returned error: %v", err)
+	}
+
+	expected := "https://jsmith.myshopify.com/account/activate/1/a1b2c3"
+	if url != expected {
+		t.Errorf("Customer.GetAccountActivationURL returned %s, expected %s", url, expected)
+	}
+}
@@ -0,0 +1,169 @@
+package goshopify
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const eventsBasePath = "events"
+const eventsResourceName = "events"
+
+// eventsMaxLimit is the maximum number of events Shopify returns per page.
+const eventsMaxLimit = 250
+
+// EventService is an interface for interfacing with the event endpoints of
+// the Shopify API.
+// See: https://shopify.dev/docs/api/admin-rest/2023-01/resources/event
+type EventService interface {
+	List(interface{}) ([]Event, error)
+	ListWithPagination(interface{}) ([]Event, *Pagination, error)
+	ListForResource(string, int64, interface{}) ([]Event, error)
+	Count(interface{}) (int, error)
+	Get(int64, interface{}) (*Event, error)
+	NewPoller(int64, EventListOptions) *EventPoller
+}
+
+// EventServiceOp handles communication with the event related methods of the
+// Shopify API.
+type EventServiceOp struct {
+	client *Client
+}
+
+// Event represents something that happened to a resource of the shop, e.g. a
+// product which was created or an order which was placed
+type Event struct {
+	ID          int64         `json:"id,omitempty" bson:"id,omitempty"`
+	SubjectID   int64         `json:"subject_id,omitempty" bson:"subject_id,omitempty"`
+	SubjectType string        `json:"subject_type,omitempty" bson:"subject_type,omitempty"`
+	Verb        string        `json:"verb,omitempty" bson:"verb,omitempty"`
+	Arguments   []interface{} `json:"arguments,omitempty" bson:"arguments,omitempty"`
+	Body        string        `json:"body,omitempty" bson:"body,omitempty"`
+	Message     string        `json:"message,omitempty" bson:"message,omitempty"`
+	Author      string        `json:"author,omitempty" bson:"author,omitempty"`
+	Description string        `json:"description,omitempty" bson:"description,omitempty"`
+	Path        string        `json:"path,omitempty" bson:"path,omitempty"`
+	CreatedAt   *time.Time    `json:"created_at,omitempty" bson:"created_at,omitempty"`
+}
+
+// EventListOptions represents the options available when listing or counting
+// events. Filter takes a comma separated list of subject types, e.g.
+// "Product,Order".
+type EventListOptions struct {
+	ListOptions
+	Filter string `url:"filter,omitempty"`
+	Verb   string `url:"verb,omitempty"`
+}
+
+// EventResource represents the result from the events/X.json endpoint
+type EventResource struct {
+	Event *Event `json:"event" bson:"event"`
+}
+
+// EventsResource represents the result from the events.json endpoint
+type EventsResource struct {
+	Events []Event `json:"events" bson:"events"`
+}
+
+// List events
+func (s *EventServiceOp) List(options interface{}) ([]Event, error) {
+	events, _, err := s.ListWithPagination(options)
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// ListWithPagination lists events and return pagination to retrieve next/previous results.
+func (s *EventServiceOp) ListWithPagination(options interface{}) ([]Event, *Pagination, error) {
+	path := fmt.Sprintf("%s.json", eventsBasePath)
+	resource := new(EventsResource)
+	headers := http.Header{}
+
+	headers, err := s.client.createAndDoGetHeaders("GET", path, nil, options, resource)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Extract pagination info from header
+	linkHeader := headers.Get("Link")
+
+	pagination, err := extractPagination(linkHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource.Events, pagination, nil
+}
+
+// ListForResource lists the events of a single resource, given by its
+// resource name and ID, e.g. "products" and the ID of a product
+func (s *EventServiceOp) ListForResource(resource string, resourceID int64, options interface{}) ([]Event, error) {
+	path := fmt.Sprintf("%s/%d/%s.json", resource, resourceID, eventsResourceName)
+	wrapped := new(EventsResource)
+	err := s.client.Get(path, wrapped, options)
+	return wrapped.Events, err
+}
+
+// Count events
+func (s *EventServiceOp) Count(options interface{}) (int, error) {
+	path := fmt.Sprintf("%s/count.json", eventsBasePath)
+	return s.client.Count(path, options)
+}
+
+// Get individual event
+func (s *EventServiceOp) Get(eventID int64, options interface{}) (*Event, error) {
+	path := fmt.Sprintf("%s/%d.json", eventsBasePath, eventID)
+	resource := new(EventResource)
+	err := s.client.Get(path, resource, options)
+	return resource.Event, err
+}
+
+// NewPoller returns an EventPoller for the events after the event with ID
+// sinceID, matching the given options. Pass 0 to start with the first event.
+func (s *EventServiceOp) NewPoller(sinceID int64, options EventListOptions) *EventPoller {
+	return &EventPoller{service: s, options: options, LastID: sinceID}
+}
+
+// EventPoller retrieves the events which happened since it last polled, for
+// incremental auditing. Persist LastID to resume after a restart.
+type EventPoller struct {
+	service EventService
+	options EventListOptions
+
+	// LastID is the ID of the last event returned by Poll
+	LastID int64
+}
+
+// Poll returns the events after LastID in ascending order, following as many
+// pages as needed, and advances LastID to the last of them. It returns no
+// events when nothing happened since the previous call. On error the events
+// retrieved so far are returned, and LastID includes them.
+func (p *EventPoller) Poll() ([]Event, error) {
+	options := p.options
+	options.PageInfo = nil
+	options.Page = nil
+	if options.Limit == nil || *options.Limit <= 0 {
+		options.Limit = PInt(eventsMaxLimit)
+	}
+
+	var events []Event
+	for {
+		options.SinceID = PInt64(p.LastID)
+		page, err := p.service.List(options)
+		if err != nil {
+			return events, err
+		}
+
+		for _, event := range page {
+			if event.ID > p.LastID {
+				p.LastID = event.ID
+			}
+		}
+		events = append(events, page...)
+
+		if len(page) < *options.Limit {
+			return events, nil
+		}
+	}
+}
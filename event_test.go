@@ -0,0 +1,210 @@
+package goshopify
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func eventTests(t *testing.T, event Event) {
+	// Check that ID is assigned to the returned event
+	expectedID := int64(677313116)
+	if event.ID != expectedID {
+		t.Errorf("Event.ID returned %+v, expected %+v", event.ID, expectedID)
+	}
+
+	if event.SubjectID != 921728736 || event.SubjectType != "Product" || event.Verb != "create" {
+		t.Errorf("Event returned %s of %s %d, expected create of Product 921728736", event.Verb, event.SubjectType, event.SubjectID)
+	}
+
+	expectedArguments := []interface{}{"IPod Touch 8GB"}
+	if !reflect.DeepEqual(event.Arguments, expectedArguments) {
+		t.Errorf("Event.Arguments returned %+v, expected %+v", event.Arguments, expectedArguments)
+	}
+
+	expectedCreatedAt := time.Date(2008, time.January, 10, 13, 0, 0, 0, time.UTC)
+	if event.CreatedAt == nil || !event.CreatedAt.Equal(expectedCreatedAt) {
+		t.Errorf("Event.CreatedAt returned %v, expected %v", event.CreatedAt, expectedCreatedAt)
+	}
+}
+
+func TestEventList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponderWithQuery("GET", fmt.Sprintf("https://"+testHost+"/%s/events.json", client.pathPrefix),
+		map[string]string{"filter": "Product,Order", "verb": "create", "created_at_min": "2008-01-01T00:00:00Z"},
+		httpmock.NewBytesResponder(200, loadFixture("events.json")))
+
+	createdAtMin := time.Date(2008, time.January, 1, 0, 0, 0, 0, time.UTC)
+	options := EventListOptions{
+		ListOptions: ListOptions{CreatedAtMin: &createdAtMin},
+		Filter:      "Product,Order",
+		Verb:        "create",
+	}
+	events, err := client.Event.List(options)
+	if err != nil {
+		t.Errorf("Event.List returned error: %v", err)
+	}
+
+	if len(events) != 1 {
+		t.Fatalf("Event.List got %d events, expected 1", len(events))
+	}
+	eventTests(t, events[0])
+}
+
+func TestEventListWithPagination(t *testing.T) {
+	setup()
+	defer teardown()
+
+	response := httpmock.NewBytesResponse(200, loadFixture("events.json"))
+	response.Header.Set("Link", `<http://valid.url?page_info=foo&limit=1>; rel="next"`)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/events.json", client.pathPrefix),
+		httpmock.ResponderFromResponse(response))
+
+	events, pagination, err := client.Event.ListWithPagination(nil)
+	if err != nil {
+		t.Errorf("Event.ListWithPagination returned error: %v", err)
+	}
+
+	if len(events) != 1 {
+		t.Fatalf("Event.ListWithPagination got %d events, expected 1", len(events))
+	}
+
+	expectedPagination := &Pagination{
+		NextPageOptions: &ListOptions{PageInfo: PString("foo"), Limit: PInt(1)},
+	}
+	if !reflect.DeepEqual(pagination, expectedPagination) {
+		t.Errorf("Event.ListWithPagination returned pagination %+v, expected %+v", pagination, expectedPagination)
+	}
+}
+
+func TestEventListForResource(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/products/921728736/events.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("events.json")))
+
+	events, err := client.Event.ListForResource(productsResourceName, 921728736, nil)
+	if err != nil {
+		t.Errorf("Event.ListForResource returned error: %v", err)
+	}
+
+	if len(events) != 1 {
+		t.Fatalf("Event.ListForResource got %d events, expected 1", len(events))
+	}
+	eventTests(t, events[0])
+}
+
+func TestEventCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponderWithQuery("GET", fmt.Sprintf("https://"+testHost+"/%s/events/count.json", client.pathPrefix),
+		map[string]string{"filter": "Order"},
+		httpmock.NewStringResponder(200, `{"count": 3}`))
+
+	cnt, err := client.Event.Count(EventListOptions{Filter: "Order"})
+	if err != nil {
+		t.Errorf("Event.Count returned error: %v", err)
+	}
+
+	expected := 3
+	if cnt != expected {
+		t.Errorf("Event.Count returned %d, expected %d", cnt, expected)
+	}
+}
+
+func TestEventGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/events/677313116.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("event.json")))
+
+	event, err := client.Event.Get(677313116, nil)
+	if err != nil {
+		t.Errorf("Event.Get returned error: %v", err)
+	}
+
+	eventTests(t, *event)
+}
+
+func TestEventPoller(t *testing.T) {
+	setup()
+	defer teardown()
+
+	// Serves the events with IDs 1 to 5 after since_id, at most limit per page
+	lastEventID := int64(5)
+	var requests []string
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/events.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			query := req.URL.Query()
+			requests = append(requests, query.Encode())
+
+			var sinceID int64
+			var limit int
+			fmt.Sscan(query.Get("since_id"), &sinceID)
+			fmt.Sscan(query.Get("limit"), &limit)
+
+			resource := EventsResource{Events: []Event{}}
+			for id := sinceID + 1; id <= lastEventID && len(resource.Events) < limit; id++ {
+				resource.Events = append(resource.Events, Event{ID: id, SubjectType: "Product"})
+			}
+			return httpmock.NewJsonResponse(200, resource)
+		})
+
+	poller := client.Event.NewPoller(1, EventListOptions{
+		ListOptions: ListOptions{Limit: PInt(2)},
+		Filter:      "Product",
+	})
+
+	events, err := poller.Poll()
+	if err != nil {
+		t.Fatalf("EventPoller.Poll returned error: %v", err)
+	}
+
+	var ids []int64
+	for _, event := range events {
+		ids = append(ids, event.ID)
+	}
+	expectedIDs := []int64{2, 3, 4, 5}
+	if !reflect.DeepEqual(ids, expectedIDs) {
+		t.Errorf("EventPoller.Poll returned events %v, expected %v", ids, expectedIDs)
+	}
+	if poller.LastID != 5 {
+		t.Errorf("EventPoller.LastID is %d, expected 5", poller.LastID)
+	}
+
+	expectedRequests := []string{
+		"filter=Product&limit=2&since_id=1",
+		"filter=Product&limit=2&since_id=3",
+		"filter=Product&limit=2&since_id=5",
+	}
+	if !reflect.DeepEqual(requests, expectedRequests) {
+		t.Errorf("EventPoller.Poll requested %v, expected %v", requests, expectedRequests)
+	}
+
+	// Nothing happened since
+	events, err = poller.Poll()
+	if err != nil {
+		t.Fatalf("EventPoller.Poll returned error: %v", err)
+	}
+	if len(events) != 0 {
+		t.Errorf("EventPoller.Poll returned %d events, expected none", len(events))
+	}
+
+	lastEventID = 6
+	events, err = poller.Poll()
+	if err != nil {
+		t.Fatalf("EventPoller.Poll returned error: %v", err)
+	}
+	if len(events) != 1 || events[0].ID != 6 || poller.LastID != 6 {
+		t.Errorf("EventPoller.Poll returned %+v, expected the event 6", events)
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"time"
 )
 
+const fulfillmentsBasePath = "fulfillments"
+
 // FulfillmentService is an interface for interfacing with the fulfillment endpoints
 // of the Shopify API.
 // https://help.shopify.com/api/reference/fulfillment
@@ -17,6 +19,8 @@ type FulfillmentService interface {
 	Complete(int64) (*Fulfillment, error)
 	Transition(int64) (*Fulfillment, error)
 	Cancel(int64) (*Fulfillment, error)
+	CreateForFulfillmentOrders(Fulfillment) (*Fulfillment, error)
+	UpdateTracking(int64, FulfillmentTrackingInfo, bool) (*Fulfillment, error)
 }
 
 // FulfillmentsService is an interface for other Shopify resources
@@ -62,6 +66,26 @@ type Fulfillment struct {
 	Name                       string     `json:"name,omitempty" bson:"name,omitempty"`
 	OriginAddress              *Address   `json:"origin_address,omitempty" bson:"origin_address,omitempty"`
 	VariantInventoryManagement string     `json:"variant_inventory_management,omitempty" bson:"variant_inventory_management,omitempty"` // webhook里面没有? // @todo
+
+	// fields used when creating a fulfillment for fulfillment orders
+	LineItemsByFulfillmentOrder []LineItemsByFulfillmentOrder `json:"line_items_by_fulfillment_order,omitempty" bson:"line_items_by_fulfillment_order,omitempty"`
+	TrackingInfo                *FulfillmentTrackingInfo      `json:"tracking_info,omitempty" bson:"tracking_info,omitempty"`
+	Message                     string                        `json:"message,omitempty" bson:"message,omitempty"`
+}
+
+// LineItemsByFulfillmentOrder selects the fulfillment order, and optionally
+// some of its line items, to be fulfilled. All the line items are fulfilled
+// if FulfillmentOrderLineItems is empty.
+type LineItemsByFulfillmentOrder struct {
+	FulfillmentOrderID        int64                      `json:"fulfillment_order_id" bson:"fulfillment_order_id"`
+	FulfillmentOrderLineItems []FulfillmentOrderLineItem `json:"fulfillment_order_line_items,omitempty" bson:"fulfillment_order_line_items,omitempty"`
+}
+
+// FulfillmentTrackingInfo represents the tracking information of a fulfillment.
+type FulfillmentTrackingInfo struct {
+	Number  string `json:"number,omitempty" bson:"number,omitempty"`
+	URL     string `json:"url,omitempty" bson:"url,omitempty"`
+	Company string `json:"company,omitempty" bson:"company,omitempty"`
 }
 
 // Receipt represents a Shopify receipt.
@@ -151,3 +175,28 @@ func (s *FulfillmentServiceOp) Cancel(fulfillmentID int64) (*Fulfillment, error)
 	err := s.client.Post(path, nil, resource)
 	return resource.Fulfillment, err
 }
+
+// CreateForFulfillmentOrders creates a fulfillment for the line items of one
+// or more fulfillment orders, set in fulfillment.LineItemsByFulfillmentOrder.
+// Unlike Create, it always uses the fulfillments.json endpoint.
+func (s *FulfillmentServiceOp) CreateForFulfillmentOrders(fulfillment Fulfillment) (*Fulfillment, error) {
+	path := fmt.Sprintf("%s.json", fulfillmentsBasePath)
+	wrappedData := FulfillmentResource{Fulfillment: &fulfillment}
+	resource := new(FulfillmentResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.Fulfillment, err
+}
+
+// UpdateTracking updates the tracking information of a fulfillment
+func (s *FulfillmentServiceOp) UpdateTracking(fulfillmentID int64, trackingInfo FulfillmentTrackingInfo, notifyCustomer bool) (*Fulfillment, error) {
+	path := fmt.Sprintf("%s/%d/update_tracking.json", fulfillmentsBasePath, fulfillmentID)
+	wrappedData := map[string]interface{}{
+		"fulfillment": map[string]interface{}{
+			"notify_customer": notifyCustomer,
+			"tracking_info":   trackingInfo,
+		},
+	}
+	resource := new(FulfillmentResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.Fulfillment, err
+}
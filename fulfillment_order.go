@@ -0,0 +1,246 @@
+package goshopify
+
+import (
+	"fmt"
+	"time"
+)
+
+const fulfillmentOrdersBasePath = "fulfillment_orders"
+const fulfillmentOrdersResourceName = "fulfillment_orders"
+
+// FulfillmentOrderService is an interface for interfacing with the fulfillment
+// order endpoints of the Shopify API.
+// See: https://shopify.dev/docs/api/admin-rest/2023-01/resources/fulfillmentorder
+type FulfillmentOrderService interface {
+	List(int64, interface{}) ([]FulfillmentOrder, error)
+	Get(int64, interface{}) (*FulfillmentOrder, error)
+	Move(int64, FulfillmentOrderMoveRequest) (*FulfillmentOrderMoveResult, error)
+	Hold(int64, FulfillmentHold) (*FulfillmentOrder, error)
+	ReleaseHold(int64) (*FulfillmentOrder, error)
+	Reschedule(int64, time.Time) (*FulfillmentOrder, error)
+	Open(int64) (*FulfillmentOrder, error)
+	Close(int64, string) (*FulfillmentOrder, error)
+	Cancel(int64) (*FulfillmentOrderCancelResult, error)
+}
+
+// FulfillmentOrderServiceOp handles communication with the fulfillment order
+// related methods of the Shopify API.
+type FulfillmentOrderServiceOp struct {
+	client *Client
+}
+
+// FulfillmentOrder represents a group of line items of an order that are
+// fulfilled from the same location.
+type FulfillmentOrder struct {
+	ID                  int64                                `json:"id,omitempty" bson:"id,omitempty"`
+	ShopID              int64                                `json:"shop_id,omitempty" bson:"shop_id,omitempty"`
+	OrderID             int64                                `json:"order_id,omitempty" bson:"order_id,omitempty"`
+	AssignedLocationID  int64                                `json:"assigned_location_id,omitempty" bson:"assigned_location_id,omitempty"`
+	RequestStatus       string                               `json:"request_status,omitempty" bson:"request_status,omitempty"`
+	Status              string                               `json:"status,omitempty" bson:"status,omitempty"`
+	SupportedActions    []string                             `json:"supported_actions,omitempty" bson:"supported_actions,omitempty"`
+	Destination         *FulfillmentOrderDestination         `json:"destination,omitempty" bson:"destination,omitempty"`
+	LineItems           []FulfillmentOrderLineItem           `json:"line_items,omitempty" bson:"line_items,omitempty"`
+	FulfillAt           *time.Time                           `json:"fulfill_at,omitempty" bson:"fulfill_at,omitempty"`
+	FulfillBy           *time.Time                           `json:"fulfill_by,omitempty" bson:"fulfill_by,omitempty"`
+	InternationalDuties *FulfillmentOrderInternationalDuties `json:"international_duties,omitempty" bson:"international_duties,omitempty"`
+	FulfillmentHolds    []FulfillmentHold                    `json:"fulfillment_holds,omitempty" bson:"fulfillment_holds,omitempty"`
+	DeliveryMethod      *FulfillmentOrderDeliveryMethod      `json:"delivery_method,omitempty" bson:"delivery_method,omitempty"`
+	AssignedLocation    *FulfillmentOrderAssignedLocation    `json:"assigned_location,omitempty" bson:"assigned_location,omitempty"`
+	MerchantRequests    []FulfillmentOrderMerchantRequest    `json:"merchant_requests,omitempty" bson:"merchant_requests,omitempty"`
+	CreatedAt           *time.Time                           `json:"created_at,omitempty" bson:"created_at,omitempty"`
+	UpdatedAt           *time.Time                           `json:"updated_at,omitempty" bson:"updated_at,omitempty"`
+}
+
+// FulfillmentOrderDestination is the address the items of a fulfillment order
+// are shipped to.
+type FulfillmentOrderDestination struct {
+	ID        int64  `json:"id,omitempty" bson:"id,omitempty"`
+	Address1  string `json:"address1,omitempty" bson:"address1,omitempty"`
+	Address2  string `json:"address2,omitempty" bson:"address2,omitempty"`
+	City      string `json:"city,omitempty" bson:"city,omitempty"`
+	Company   string `json:"company,omitempty" bson:"company,omitempty"`
+	Country   string `json:"country,omitempty" bson:"country,omitempty"`
+	Email     string `json:"email,omitempty" bson:"email,omitempty"`
+	FirstName string `json:"first_name,omitempty" bson:"first_name,omitempty"`
+	LastName  string `json:"last_name,omitempty" bson:"last_name,omitempty"`
+	Phone     string `json:"phone,omitempty" bson:"phone,omitempty"`
+	Province  string `json:"province,omitempty" bson:"province,omitempty"`
+	Zip       string `json:"zip,omitempty" bson:"zip,omitempty"`
+}
+
+// FulfillmentOrderLineItem represents a line item of a fulfillment order. Only
+// ID and Quantity are used when selecting line items in requests.
+type FulfillmentOrderLineItem struct {
+	ID                  int64 `json:"id,omitempty" bson:"id,omitempty"`
+	ShopID              int64 `json:"shop_id,omitempty" bson:"shop_id,omitempty"`
+	FulfillmentOrderID  int64 `json:"fulfillment_order_id,omitempty" bson:"fulfillment_order_id,omitempty"`
+	LineItemID          int64 `json:"line_item_id,omitempty" bson:"line_item_id,omitempty"`
+	InventoryItemID     int64 `json:"inventory_item_id,omitempty" bson:"inventory_item_id,omitempty"`
+	VariantID           int64 `json:"variant_id,omitempty" bson:"variant_id,omitempty"`
+	Quantity            int   `json:"quantity,omitempty" bson:"quantity,omitempty"`
+	FulfillableQuantity int   `json:"fulfillable_quantity,omitempty" bson:"fulfillable_quantity,omitempty"`
+}
+
+// FulfillmentOrderInternationalDuties holds the duty terms of an
+// international shipment.
+type FulfillmentOrderInternationalDuties struct {
+	Incoterm string `json:"incoterm,omitempty" bson:"incoterm,omitempty"`
+}
+
+// FulfillmentHold represents a hold on a fulfillment order. When holding a
+// fulfillment order, NotifyMerchant and FulfillmentOrderLineItems can be set
+// to notify the merchant and to hold only some of the line items.
+type FulfillmentHold struct {
+	Reason                    string                     `json:"reason,omitempty" bson:"reason,omitempty"`
+	ReasonNotes               string                     `json:"reason_notes,omitempty" bson:"reason_notes,omitempty"`
+	NotifyMerchant            bool                       `json:"notify_merchant,omitempty" bson:"notify_merchant,omitempty"`
+	FulfillmentOrderLineItems []FulfillmentOrderLineItem `json:"fulfillment_order_line_items,omitempty" bson:"fulfillment_order_line_items,omitempty"`
+}
+
+// FulfillmentOrderDeliveryMethod describes how the items of a fulfillment order
+// are delivered.
+type FulfillmentOrderDeliveryMethod struct {
+	ID                  int64      `json:"id,omitempty" bson:"id,omitempty"`
+	MethodType          string     `json:"method_type,omitempty" bson:"method_type,omitempty"`
+	MinDeliveryDateTime *time.Time `json:"min_delivery_date_time,omitempty" bson:"min_delivery_date_time,omitempty"`
+	MaxDeliveryDateTime *time.Time `json:"max_delivery_date_time,omitempty" bson:"max_delivery_date_time,omitempty"`
+}
+
+// FulfillmentOrderAssignedLocation is the location a fulfillment order is
+// assigned to.
+type FulfillmentOrderAssignedLocation struct {
+	LocationID  int64  `json:"location_id,omitempty" bson:"location_id,omitempty"`
+	Name        string `json:"name,omitempty" bson:"name,omitempty"`
+	Address1    string `json:"address1,omitempty" bson:"address1,omitempty"`
+	Address2    string `json:"address2,omitempty" bson:"address2,omitempty"`
+	City        string `json:"city,omitempty" bson:"city,omitempty"`
+	CountryCode string `json:"country_code,omitempty" bson:"country_code,omitempty"`
+	Phone       string `json:"phone,omitempty" bson:"phone,omitempty"`
+	Province    string `json:"province,omitempty" bson:"province,omitempty"`
+	Zip         string `json:"zip,omitempty" bson:"zip,omitempty"`
+}
+
+// FulfillmentOrderMerchantRequest is a request sent by the merchant to the
+// fulfillment service of a fulfillment order.
+type FulfillmentOrderMerchantRequest struct {
+	Message        string                 `json:"message,omitempty" bson:"message,omitempty"`
+	RequestOptions map[string]interface{} `json:"request_options,omitempty" bson:"request_options,omitempty"`
+	Kind           string                 `json:"kind,omitempty" bson:"kind,omitempty"`
+}
+
+// FulfillmentOrderMoveRequest moves a fulfillment order, or some of its line
+// items, to a new location.
+type FulfillmentOrderMoveRequest struct {
+	NewLocationID             int64                      `json:"new_location_id" bson:"new_location_id"`
+	FulfillmentOrderLineItems []FulfillmentOrderLineItem `json:"fulfillment_order_line_items,omitempty" bson:"fulfillment_order_line_items,omitempty"`
+}
+
+// FulfillmentOrderMoveResult represents the result from the
+// fulfillment_orders/X/move.json endpoint
+type FulfillmentOrderMoveResult struct {
+	OriginalFulfillmentOrder  *FulfillmentOrder `json:"original_fulfillment_order" bson:"original_fulfillment_order"`
+	MovedFulfillmentOrder     *FulfillmentOrder `json:"moved_fulfillment_order" bson:"moved_fulfillment_order"`
+	RemainingFulfillmentOrder *FulfillmentOrder `json:"remaining_fulfillment_order" bson:"remaining_fulfillment_order"`
+}
+
+// FulfillmentOrderCancelResult represents the result from the
+// fulfillment_orders/X/cancel.json endpoint
+type FulfillmentOrderCancelResult struct {
+	FulfillmentOrder            *FulfillmentOrder `json:"fulfillment_order" bson:"fulfillment_order"`
+	ReplacementFulfillmentOrder *FulfillmentOrder `json:"replacement_fulfillment_order" bson:"replacement_fulfillment_order"`
+}
+
+// FulfillmentOrderResource represents the result from the fulfillment_orders/X.json endpoint
+type FulfillmentOrderResource struct {
+	FulfillmentOrder *FulfillmentOrder `json:"fulfillment_order" bson:"fulfillment_order"`
+}
+
+// FulfillmentOrdersResource represents the result from the orders/X/fulfillment_orders.json endpoint
+type FulfillmentOrdersResource struct {
+	FulfillmentOrders []FulfillmentOrder `json:"fulfillment_orders" bson:"fulfillment_orders"`
+}
+
+// FulfillmentHoldResource is the request body of the fulfillment_orders/X/hold.json endpoint
+type FulfillmentHoldResource struct {
+	FulfillmentHold *FulfillmentHold `json:"fulfillment_hold" bson:"fulfillment_hold"`
+}
+
+// List fulfillment orders of an order
+func (s *FulfillmentOrderServiceOp) List(orderID int64, options interface{}) ([]FulfillmentOrder, error) {
+	path := fmt.Sprintf("%s/%d/%s.json", ordersBasePath, orderID, fulfillmentOrdersResourceName)
+	resource := new(FulfillmentOrdersResource)
+	err := s.client.Get(path, resource, options)
+	return resource.FulfillmentOrders, err
+}
+
+// Get individual fulfillment order
+func (s *FulfillmentOrderServiceOp) Get(fulfillmentOrderID int64, options interface{}) (*FulfillmentOrder, error) {
+	path := fmt.Sprintf("%s/%d.json", fulfillmentOrdersBasePath, fulfillmentOrderID)
+	resource := new(FulfillmentOrderResource)
+	err := s.client.Get(path, resource, options)
+	return resource.FulfillmentOrder, err
+}
+
+// Move a fulfillment order to a new location
+func (s *FulfillmentOrderServiceOp) Move(fulfillmentOrderID int64, move FulfillmentOrderMoveRequest) (*FulfillmentOrderMoveResult, error) {
+	path := fmt.Sprintf("%s/%d/move.json", fulfillmentOrdersBasePath, fulfillmentOrderID)
+	wrappedData := map[string]FulfillmentOrderMoveRequest{"fulfillment_order": move}
+	resource := new(FulfillmentOrderMoveResult)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource, err
+}
+
+// Hold a fulfillment order, halting its fulfillment
+func (s *FulfillmentOrderServiceOp) Hold(fulfillmentOrderID int64, hold FulfillmentHold) (*FulfillmentOrder, error) {
+	path := fmt.Sprintf("%s/%d/hold.json", fulfillmentOrdersBasePath, fulfillmentOrderID)
+	wrappedData := FulfillmentHoldResource{FulfillmentHold: &hold}
+	resource := new(FulfillmentOrderResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.FulfillmentOrder, err
+}
+
+// ReleaseHold releases the holds on a fulfillment order
+func (s *FulfillmentOrderServiceOp) ReleaseHold(fulfillmentOrderID int64) (*FulfillmentOrder, error) {
+	path := fmt.Sprintf("%s/%d/release_hold.json", fulfillmentOrdersBasePath, fulfillmentOrderID)
+	resource := new(FulfillmentOrderResource)
+	err := s.client.Post(path, nil, resource)
+	return resource.FulfillmentOrder, err
+}
+
+// Reschedule a scheduled fulfillment order to be fulfilled at a new time
+func (s *FulfillmentOrderServiceOp) Reschedule(fulfillmentOrderID int64, fulfillAt time.Time) (*FulfillmentOrder, error) {
+	path := fmt.Sprintf("%s/%d/reschedule.json", fulfillmentOrdersBasePath, fulfillmentOrderID)
+	wrappedData := map[string]map[string]time.Time{"fulfillment_order": {"new_fulfill_at": fulfillAt}}
+	resource := new(FulfillmentOrderResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.FulfillmentOrder, err
+}
+
+// Open a scheduled fulfillment order, so it can be fulfilled right away
+func (s *FulfillmentOrderServiceOp) Open(fulfillmentOrderID int64) (*FulfillmentOrder, error) {
+	path := fmt.Sprintf("%s/%d/open.json", fulfillmentOrdersBasePath, fulfillmentOrderID)
+	resource := new(FulfillmentOrderResource)
+	err := s.client.Post(path, nil, resource)
+	return resource.FulfillmentOrder, err
+}
+
+// Close a fulfillment order as incomplete, with an optional message
+func (s *FulfillmentOrderServiceOp) Close(fulfillmentOrderID int64, message string) (*FulfillmentOrder, error) {
+	path := fmt.Sprintf("%s/%d/close.json", fulfillmentOrdersBasePath, fulfillmentOrderID)
+	var wrappedData interface{}
+	if message != "" {
+		wrappedData = map[string]map[string]string{"fulfillment_order": {"message": message}}
+	}
+	resource := new(FulfillmentOrderResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.FulfillmentOrder, err
+}
+
+// Cancel a fulfillment order, returning it and its replacement
+func (s *FulfillmentOrderServiceOp) Cancel(fulfillmentOrderID int64) (*FulfillmentOrderCancelResult, error) {
+	path := fmt.Sprintf("%s/%d/cancel.json", fulfillmentOrdersBasePath, fulfillmentOrderID)
+	resource := new(FulfillmentOrderCancelResult)
+	err := s.client.Post(path, nil, resource)
+	return resource, err
+}
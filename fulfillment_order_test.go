@@ -0,0 +1,260 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func fulfillmentOrderTests(t *testing.T, fulfillmentOrder FulfillmentOrder) {
+	// Check that ID is assigned to the returned fulfillment order
+	expectedID := int64(1046000818)
+	if fulfillmentOrder.ID != expectedID {
+		t.Errorf("FulfillmentOrder.ID returned %+v, expected %+v", fulfillmentOrder.ID, expectedID)
+	}
+
+	// Check that the OrderID value is assigned to the returned fulfillment order
+	expectedOrderID := int64(450789469)
+	if fulfillmentOrder.OrderID != expectedOrderID {
+		t.Errorf("FulfillmentOrder.OrderID returned %+v, expected %+v", fulfillmentOrder.OrderID, expectedOrderID)
+	}
+
+	expectedLineItems := []FulfillmentOrderLineItem{
+		{
+			ID:                  1025578643,
+			ShopID:              548380009,
+			FulfillmentOrderID:  1046000818,
+			LineItemID:          466157049,
+			InventoryItemID:     39072856,
+			VariantID:           39072856,
+			Quantity:            1,
+			FulfillableQuantity: 1,
+		},
+	}
+	if !reflect.DeepEqual(fulfillmentOrder.LineItems, expectedLineItems) {
+		t.Errorf("FulfillmentOrder.LineItems returned %+v, expected %+v", fulfillmentOrder.LineItems, expectedLineItems)
+	}
+
+	if fulfillmentOrder.AssignedLocation == nil || fulfillmentOrder.AssignedLocation.LocationID != 24826418 {
+		t.Errorf("FulfillmentOrder.AssignedLocation returned %+v, expected location 24826418", fulfillmentOrder.AssignedLocation)
+	}
+}
+
+// fulfillmentOrderRequest returns a responder recording the body of the
+// request in sent, and responding with the fulfillment order fixture.
+func fulfillmentOrderRequest(sent *map[string]map[string]interface{}) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		if req.ContentLength > 0 {
+			if err := json.NewDecoder(req.Body).Decode(sent); err != nil {
+				return nil, err
+			}
+		}
+		return httpmock.NewBytesResponse(200, loadFixture("fulfillment_order.json")), nil
+	}
+}
+
+func TestFulfillmentOrderList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/orders/450789469/fulfillment_orders.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("fulfillment_orders.json")))
+
+	fulfillmentOrders, err := client.FulfillmentOrder.List(450789469, nil)
+	if err != nil {
+		t.Errorf("FulfillmentOrder.List returned error: %v", err)
+	}
+
+	if len(fulfillmentOrders) != 1 {
+		t.Fatalf("FulfillmentOrder.List got %d fulfillment orders, expected 1", len(fulfillmentOrders))
+	}
+	fulfillmentOrderTests(t, fulfillmentOrders[0])
+}
+
+func TestFulfillmentOrderGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/fulfillment_orders/1046000818.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("fulfillment_order.json")))
+
+	fulfillmentOrder, err := client.FulfillmentOrder.Get(1046000818, nil)
+	if err != nil {
+		t.Errorf("FulfillmentOrder.Get returned error: %v", err)
+	}
+
+	fulfillmentOrderTests(t, *fulfillmentOrder)
+}
+
+func TestFulfillmentOrderMove(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var sent map[string]map[string]interface{}
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://"+testHost+"/%s/fulfillment_orders/1046000818/move.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&sent); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(200, `{"original_fulfillment_order":{"id":1046000818,"status":"closed"},"moved_fulfillment_order":{"id":1046000819,"assigned_location_id":655441491},"remaining_fulfillment_order":null}`), nil
+		})
+
+	move := FulfillmentOrderMoveRequest{
+		NewLocationID:             655441491,
+		FulfillmentOrderLineItems: []FulfillmentOrderLineItem{{ID: 1025578643, Quantity: 1}},
+	}
+	result, err := client.FulfillmentOrder.Move(1046000818, move)
+	if err != nil {
+		t.Errorf("FulfillmentOrder.Move returned error: %v", err)
+	}
+
+	expected := &FulfillmentOrderMoveResult{
+		OriginalFulfillmentOrder: &FulfillmentOrder{ID: 1046000818, Status: "closed"},
+		MovedFulfillmentOrder:    &FulfillmentOrder{ID: 1046000819, AssignedLocationID: 655441491},
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("FulfillmentOrder.Move returned %+v, expected %+v", result, expected)
+	}
+
+	expectedSent := map[string]map[string]interface{}{
+		"fulfillment_order": {
+			"new_location_id": float64(655441491),
+			"fulfillment_order_line_items": []interface{}{
+				map[string]interface{}{"id": float64(1025578643), "quantity": float64(1)},
+			},
+		},
+	}
+	if !reflect.DeepEqual(sent, expectedSent) {
+		t.Errorf("FulfillmentOrder.Move sent %+v, expected %+v", sent, expectedSent)
+	}
+}
+
+func TestFulfillmentOrderHold(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var sent map[string]map[string]interface{}
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://"+testHost+"/%s/fulfillment_orders/1046000818/hold.json", client.pathPrefix),
+		fulfillmentOrderRequest(&sent))
+
+	hold := FulfillmentHold{
+		Reason:         "inventory_out_of_stock",
+		ReasonNotes:    "Not enough inventory to complete this work.",
+		NotifyMerchant: true,
+	}
+	fulfillmentOrder, err := client.FulfillmentOrder.Hold(1046000818, hold)
+	if err != nil {
+		t.Errorf("FulfillmentOrder.Hold returned error: %v", err)
+	}
+
+	fulfillmentOrderTests(t, *fulfillmentOrder)
+
+	expectedSent := map[string]map[string]interface{}{
+		"fulfillment_hold": {
+			"reason":          "inventory_out_of_stock",
+			"reason_notes":    "Not enough inventory to complete this work.",
+			"notify_merchant": true,
+		},
+	}
+	if !reflect.DeepEqual(sent, expectedSent) {
+		t.Errorf("FulfillmentOrder.Hold sent %+v, expected %+v", sent, expectedSent)
+	}
+}
+
+func TestFulfillmentOrderReschedule(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var sent map[string]map[string]interface{}
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://"+testHost+"/%s/fulfillment_orders/1046000818/reschedule.json", client.pathPrefix),
+		fulfillmentOrderRequest(&sent))
+
+	fulfillAt := time.Date(2024, 1, 3, 13, 23, 20, 0, time.UTC)
+	fulfillmentOrder, err := client.FulfillmentOrder.Reschedule(1046000818, fulfillAt)
+	if err != nil {
+		t.Errorf("FulfillmentOrder.Reschedule returned error: %v", err)
+	}
+
+	fulfillmentOrderTests(t, *fulfillmentOrder)
+
+	expectedSent := map[string]map[string]interface{}{
+		"fulfillment_order": {"new_fulfill_at": "2024-01-03T13:23:20Z"},
+	}
+	if !reflect.DeepEqual(sent, expectedSent) {
+		t.Errorf("FulfillmentOrder.Reschedule sent %+v, expected %+v", sent, expectedSent)
+	}
+}
+
+func TestFulfillmentOrderClose(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var sent map[string]map[string]interface{}
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://"+testHost+"/%s/fulfillment_orders/1046000818/close.json", client.pathPrefix),
+		fulfillmentOrderRequest(&sent))
+
+	fulfillmentOrder, err := client.FulfillmentOrder.Close(1046000818, "Not enough inventory to complete this work.")
+	if err != nil {
+		t.Errorf("FulfillmentOrder.Close returned error: %v", err)
+	}
+
+	fulfillmentOrderTests(t, *fulfillmentOrder)
+
+	expectedSent := map[string]map[string]interface{}{
+		"fulfillment_order": {"message": "Not enough inventory to complete this work."},
+	}
+	if !reflect.DeepEqual(sent, expectedSent) {
+		t.Errorf("FulfillmentOrder.Close sent %+v, expected %+v", sent, expectedSent)
+	}
+}
+
+func TestFulfillmentOrderActions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	cases := []struct {
+		action string
+		call   func(int64) (*FulfillmentOrder, error)
+	}{
+		{"release_hold", client.FulfillmentOrder.ReleaseHold},
+		{"open", client.FulfillmentOrder.Open},
+	}
+
+	for _, c := range cases {
+		httpmock.RegisterResponder("POST", fmt.Sprintf("https://"+testHost+"/%s/fulfillment_orders/1046000818/%s.json", client.pathPrefix, c.action),
+			httpmock.NewBytesResponder(200, loadFixture("fulfillment_order.json")))
+
+		fulfillmentOrder, err := c.call(1046000818)
+		if err != nil {
+			t.Errorf("FulfillmentOrder %s returned error: %v", c.action, err)
+			continue
+		}
+		fulfillmentOrderTests(t, *fulfillmentOrder)
+	}
+}
+
+func TestFulfillmentOrderCancel(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://"+testHost+"/%s/fulfillment_orders/1046000818/cancel.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"fulfillment_order":{"id":1046000818,"status":"closed"},"replacement_fulfillment_order":{"id":1046000820,"status":"open"}}`))
+
+	result, err := client.FulfillmentOrder.Cancel(1046000818)
+	if err != nil {
+		t.Errorf("FulfillmentOrder.Cancel returned error: %v", err)
+	}
+
+	expected := &FulfillmentOrderCancelResult{
+		FulfillmentOrder:            &FulfillmentOrder{ID: 1046000818, Status: "closed"},
+		ReplacementFulfillmentOrder: &FulfillmentOrder{ID: 1046000820, Status: "open"},
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("FulfillmentOrder.Cancel returned %+v, expected %+v", result, expected)
+	}
+}
@@ -0,0 +1,315 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const fulfillmentServicesBasePath = "fulfillment_services"
+
+// FulfillmentServiceProviderService is an interface for the fulfillment
+// service side of the Shopify API: registering the fulfillment service and
+// answering the fulfillment and cancellation requests on the fulfillment
+// orders assigned to it.
+// See: https://shopify.dev/docs/api/admin-rest/2023-01/resources/fulfillmentservice
+type FulfillmentServiceProviderService interface {
+	List(interface{}) ([]FulfillmentServiceProvider, error)
+	Get(int64, interface{}) (*FulfillmentServiceProvider, error)
+	Create(FulfillmentServiceProvider) (*FulfillmentServiceProvider, error)
+	Update(FulfillmentServiceProvider) (*FulfillmentServiceProvider, error)
+	Delete(int64) error
+	ListAssignedFulfillmentOrders(interface{}) ([]FulfillmentOrder, error)
+	AcceptFulfillmentRequest(int64, string) (*FulfillmentOrder, error)
+	RejectFulfillmentRequest(int64, FulfillmentRequestRejection) (*FulfillmentOrder, error)
+	AcceptCancellationRequest(int64, string) (*FulfillmentOrder, error)
+	RejectCancellationRequest(int64, string) (*FulfillmentOrder, error)
+}
+
+// FulfillmentServiceProviderServiceOp handles communication with the
+// fulfillment service related methods of the Shopify API.
+type FulfillmentServiceProviderServiceOp struct {
+	client *Client
+}
+
+// FulfillmentServiceProvider represents a Shopify fulfillment service, a
+// third party warehouse that prepares and ships orders on behalf of the
+// store owner.
+type FulfillmentServiceProvider struct {
+	ID                     int64  `json:"id,omitempty" bson:"id,omitempty"`
+	Name                   string `json:"name,omitempty" bson:"name,omitempty"`
+	Email                  string `json:"email,omitempty" bson:"email,omitempty"`
+	ServiceName            string `json:"service_name,omitempty" bson:"service_name,omitempty"`
+	Handle                 string `json:"handle,omitempty" bson:"handle,omitempty"`
+	CallbackURL            string `json:"callback_url,omitempty" bson:"callback_url,omitempty"`
+	Format                 string `json:"format,omitempty" bson:"format,omitempty"`
+	ProviderID             string `json:"provider_id,omitempty" bson:"provider_id,omitempty"`
+	LocationID             int64  `json:"location_id,omitempty" bson:"location_id,omitempty"`
+	FulfillmentOrdersOptIn bool   `json:"fulfillment_orders_opt_in" bson:"fulfillment_orders_opt_in"`
+	InventoryManagement    bool   `json:"inventory_management" bson:"inventory_management"`
+	TrackingSupport        bool   `json:"tracking_support" bson:"tracking_support"`
+	RequiresShippingMethod bool   `json:"requires_shipping_method" bson:"requires_shipping_method"`
+	IncludePendingStock    bool   `json:"include_pending_stock" bson:"include_pending_stock"`
+	PermitsSkuSharing      bool   `json:"permits_sku_sharing" bson:"permits_sku_sharing"`
+	AdminGraphqlAPIID      string `json:"admin_graphql_api_id,omitempty" bson:"admin_graphql_api_id,omitempty"`
+}
+
+// FulfillmentServiceProviderListOptions can be used to list the fulfillment
+// services of other apps too, by setting Scope to "all".
+type FulfillmentServiceProviderListOptions struct {
+	Scope string `url:"scope,omitempty"`
+}
+
+// AssignedFulfillmentOrderOptions filters the fulfillment orders assigned to
+// the locations of the fulfillment service.
+type AssignedFulfillmentOrderOptions struct {
+	// AssignmentStatus is one of "cancellation_requested",
+	// "fulfillment_requested" or "fulfillment_accepted"
+	AssignmentStatus string  `url:"assignment_status,omitempty"`
+	LocationIDs      []int64 `url:"location_ids,omitempty,brackets"`
+}
+
+// FulfillmentRequestRejection is the reason for rejecting a fulfillment
+// request, optionally for some of the line items only.
+type FulfillmentRequestRejection struct {
+	Message   string                                `json:"message,omitempty" bson:"message,omitempty"`
+	Reason    string                                `json:"reason,omitempty" bson:"reason,omitempty"`
+	LineItems []FulfillmentRequestRejectionLineItem `json:"line_items,omitempty" bson:"line_items,omitempty"`
+}
+
+// FulfillmentRequestRejectionLineItem is the reason for rejecting a line item
+// of a fulfillment request.
+type FulfillmentRequestRejectionLineItem struct {
+	FulfillmentOrderLineItemID int64  `json:"fulfillment_order_line_item_id" bson:"fulfillment_order_line_item_id"`
+	Message                    string `json:"message,omitempty" bson:"message,omitempty"`
+}
+
+// FulfillmentServiceProviderResource represents the result from the fulfillment_services/X.json endpoint
+type FulfillmentServiceProviderResource struct {
+	FulfillmentService *FulfillmentServiceProvider `json:"fulfillment_service" bson:"fulfillment_service"`
+}
+
+// FulfillmentServiceProvidersResource represents the result from the fulfillment_services.json endpoint
+type FulfillmentServiceProvidersResource struct {
+	FulfillmentServices []FulfillmentServiceProvider `json:"fulfillment_services" bson:"fulfillment_services"`
+}
+
+// List fulfillment services
+func (s *FulfillmentServiceProviderServiceOp) List(options interface{}) ([]FulfillmentServiceProvider, error) {
+	path := fmt.Sprintf("%s.json", fulfillmentServicesBasePath)
+	resource := new(FulfillmentServiceProvidersResource)
+	err := s.client.Get(path, resource, options)
+	return resource.FulfillmentServices, err
+}
+
+// Get individual fulfillment service
+func (s *FulfillmentServiceProviderServiceOp) Get(fulfillmentServiceID int64, options interface{}) (*FulfillmentServiceProvider, error) {
+	path := fmt.Sprintf("%s/%d.json", fulfillmentServicesBasePath, fulfillmentServiceID)
+	resource := new(FulfillmentServiceProviderResource)
+	err := s.client.Get(path, resource, options)
+	return resource.FulfillmentService, err
+}
+
+// Create a new fulfillment service
+func (s *FulfillmentServiceProviderServiceOp) Create(fulfillmentService FulfillmentServiceProvider) (*FulfillmentServiceProvider, error) {
+	path := fmt.Sprintf("%s.json", fulfillmentServicesBasePath)
+	wrappedData := FulfillmentServiceProviderResource{FulfillmentService: &fulfillmentService}
+	resource := new(FulfillmentServiceProviderResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.FulfillmentService, err
+}
+
+// Update an existing fulfillment service
+func (s *FulfillmentServiceProviderServiceOp) Update(fulfillmentService FulfillmentServiceProvider) (*FulfillmentServiceProvider, error) {
+	path := fmt.Sprintf("%s/%d.json", fulfillmentServicesBasePath, fulfillmentService.ID)
+	wrappedData := FulfillmentServiceProviderResource{FulfillmentService: &fulfillmentService}
+	resource := new(FulfillmentServiceProviderResource)
+	err := s.client.Put(path, wrappedData, resource)
+	return resource.FulfillmentService, err
+}
+
+// Delete an existing fulfillment service
+func (s *FulfillmentServiceProviderServiceOp) Delete(fulfillmentServiceID int64) error {
+	return s.client.Delete(fmt.Sprintf("%s/%d.json", fulfillmentServicesBasePath, fulfillmentServiceID))
+}
+
+// ListAssignedFulfillmentOrders lists the fulfillment orders assigned to the
+// locations of the fulfillment service
+func (s *FulfillmentServiceProviderServiceOp) ListAssignedFulfillmentOrders(options interface{}) ([]FulfillmentOrder, error) {
+	path := "assigned_fulfillment_orders.json"
+	resource := new(FulfillmentOrdersResource)
+	err := s.client.Get(path, resource, options)
+	return resource.FulfillmentOrders, err
+}
+
+// AcceptFulfillmentRequest accepts the fulfillment request sent to the
+// fulfillment service for a fulfillment order
+func (s *FulfillmentServiceProviderServiceOp) AcceptFulfillmentRequest(fulfillmentOrderID int64, message string) (*FulfillmentOrder, error) {
+	path := fmt.Sprintf("%s/%d/fulfillment_request/accept.json", fulfillmentOrdersBasePath, fulfillmentOrderID)
+	wrappedData := map[string]FulfillmentRequestRejection{"fulfillment_request": {Message: message}}
+	resource := new(FulfillmentOrderResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.FulfillmentOrder, err
+}
+
+// RejectFulfillmentRequest rejects the fulfillment request sent to the
+// fulfillment service for a fulfillment order
+func (s *FulfillmentServiceProviderServiceOp) RejectFulfillmentRequest(fulfillmentOrderID int64, rejection FulfillmentRequestRejection) (*FulfillmentOrder, error) {
+	path := fmt.Sprintf("%s/%d/fulfillment_request/reject.json", fulfillmentOrdersBasePath, fulfillmentOrderID)
+	wrappedData := map[string]FulfillmentRequestRejection{"fulfillment_request": rejection}
+	resource := new(FulfillmentOrderResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.FulfillmentOrder, err
+}
+
+// AcceptCancellationRequest accepts the cancellation request sent to the
+// fulfillment service for a fulfillment order
+func (s *FulfillmentServiceProviderServiceOp) AcceptCancellationRequest(fulfillmentOrderID int64, message string) (*FulfillmentOrder, error) {
+	return s.answerCancellationRequest(fulfillmentOrderID, "accept", message)
+}
+
+// RejectCancellationRequest rejects the cancellation request sent to the
+// fulfillment service for a fulfillment order
+func (s *FulfillmentServiceProviderServiceOp) RejectCancellationRequest(fulfillmentOrderID int64, message string) (*FulfillmentOrder, error) {
+	return s.answerCancellationRequest(fulfillmentOrderID, "reject", message)
+}
+
+func (s *FulfillmentServiceProviderServiceOp) answerCancellationRequest(fulfillmentOrderID int64, answer string, message string) (*FulfillmentOrder, error) {
+	path := fmt.Sprintf("%s/%d/cancellation_request/%s.json", fulfillmentOrdersBasePath, fulfillmentOrderID, answer)
+	var wrappedData interface{}
+	if message != "" {
+		wrappedData = map[string]map[string]string{"cancellation_request": {"message": message}}
+	}
+	resource := new(FulfillmentOrderResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.FulfillmentOrder, err
+}
+
+// FetchTrackingNumbersRequest is the fetch_tracking_numbers callback sent by
+// Shopify to a fulfillment service with tracking support.
+type FetchTrackingNumbersRequest struct {
+	Shop       string
+	OrderNames []string
+}
+
+// FetchStockRequest is the fetch_stock callback sent by Shopify to a
+// fulfillment service with inventory management. SKU is empty when the
+// stock of all the SKUs is requested.
+type FetchStockRequest struct {
+	Shop          string
+	SKU           string
+	LocationID    int64
+	MaxRetrievals int
+	Page          int
+}
+
+// FulfillmentServiceCallbacks is implemented by fulfillment services to answer
+// the callbacks served by FulfillmentServiceHandler.
+type FulfillmentServiceCallbacks interface {
+	// FetchTrackingNumbers returns the tracking numbers keyed by order name.
+	FetchTrackingNumbers(FetchTrackingNumbersRequest) (map[string]string, error)
+
+	// FetchStock returns the inventory levels keyed by SKU. Levels without
+	// an Available quantity are reported as out of stock.
+	FetchStock(FetchStockRequest) (map[string]InventoryLevel, error)
+}
+
+// FulfillmentServiceHandler is an http.Handler serving the
+// fetch_tracking_numbers and fetch_stock callbacks of a fulfillment service,
+// to be mounted at the callback_url of the fulfillment service. The callbacks
+// are GET requests without a body, so the hmac parameter of their query is
+// verified with App.VerifyAuthorizationURL before Callbacks is called, and
+// requests without it are rejected.
+type FulfillmentServiceHandler struct {
+	App       App
+	Callbacks FulfillmentServiceCallbacks
+}
+
+// NewFulfillmentServiceHandler returns a FulfillmentServiceHandler for the app
+func NewFulfillmentServiceHandler(app App, callbacks FulfillmentServiceCallbacks) *FulfillmentServiceHandler {
+	return &FulfillmentServiceHandler{App: app, Callbacks: callbacks}
+}
+
+// ServeHTTP implements http.Handler
+func (h *FulfillmentServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	callback := strings.TrimSuffix(r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:], ".json")
+	if callback != "fetch_tracking_numbers" && callback != "fetch_stock" {
+		http.NotFound(w, r)
+		return
+	}
+
+	if ok, err := h.App.VerifyAuthorizationURL(r.URL); !ok || err != nil || r.URL.Query().Get("hmac") == "" {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	var response interface{}
+	var err error
+	if callback == "fetch_tracking_numbers" {
+		response, err = h.fetchTrackingNumbers(r)
+	} else {
+		response, err = h.fetchStock(r)
+	}
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+func (h *FulfillmentServiceHandler) fetchTrackingNumbers(r *http.Request) (interface{}, error) {
+	q := r.URL.Query()
+	req := FetchTrackingNumbersRequest{
+		Shop:       q.Get("shop"),
+		OrderNames: append(q["order_names[]"], q["order_names"]...),
+	}
+
+	trackingNumbers, err := h.Callbacks.FetchTrackingNumbers(req)
+	if err != nil {
+		return nil, err
+	}
+	if trackingNumbers == nil {
+		trackingNumbers = map[string]string{}
+	}
+
+	return struct {
+		TrackingNumbers map[string]string `json:"tracking_numbers"`
+		Message         string            `json:"message"`
+		Success         bool              `json:"success"`
+	}{
+		TrackingNumbers: trackingNumbers,
+		Message:         "Successfully received the tracking numbers",
+		Success:         true,
+	}, nil
+}
+
+func (h *FulfillmentServiceHandler) fetchStock(r *http.Request) (interface{}, error) {
+	q := r.URL.Query()
+	req := FetchStockRequest{
+		Shop: q.Get("shop"),
+		SKU:  q.Get("sku"),
+	}
+	// the numeric parameters are optional, and ignored when malformed
+	req.LocationID, _ = strconv.ParseInt(q.Get("location_id"), 10, 64)
+	req.MaxRetrievals, _ = strconv.Atoi(q.Get("max_retrievals"))
+	req.Page, _ = strconv.Atoi(q.Get("page"))
+
+	levels, err := h.Callbacks.FetchStock(req)
+	if err != nil {
+		return nil, err
+	}
+
+	stock := make(map[string]int, len(levels))
+	for sku, level := range levels {
+		if level.Available != nil {
+			stock[sku] = *level.Available
+		} else {
+			stock[sku] = 0
+		}
+	}
+	return stock, nil
+}
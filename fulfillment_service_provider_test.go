@@ -0,0 +1,359 @@
+package goshopify
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func fulfillmentServiceProviderTests(t *testing.T, fulfillmentService FulfillmentServiceProvider) {
+	expected := FulfillmentServiceProvider{
+		ID:                     755357713,
+		Name:                   "Jupiter Fulfillment",
+		Email:                  "aaa@gmail.com",
+		ServiceName:            "Jupiter Fulfillment",
+		Handle:                 "jupiter-fulfillment",
+		CallbackURL:            "http://google.com/",
+		Format:                 "json",
+		ProviderID:             "jupiter",
+		LocationID:             1072404542,
+		FulfillmentOrdersOptIn: true,
+		InventoryManagement:    true,
+		TrackingSupport:        true,
+		AdminGraphqlAPIID:      "gid://shopify/ApiFulfillmentService/755357713",
+	}
+	if !reflect.DeepEqual(fulfillmentService, expected) {
+		t.Errorf("FulfillmentServiceProvider returned %+v, expected %+v", fulfillmentService, expected)
+	}
+}
+
+func TestFulfillmentServiceProviderList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/fulfillment_services.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("fulfillment_services.json")))
+
+	fulfillmentServices, err := client.FulfillmentServiceProvider.List(FulfillmentServiceProviderListOptions{Scope: "all"})
+	if err != nil {
+		t.Errorf("FulfillmentServiceProvider.List returned error: %v", err)
+	}
+
+	if len(fulfillmentServices) != 1 {
+		t.Fatalf("FulfillmentServiceProvider.List got %d fulfillment services, expected 1", len(fulfillmentServices))
+	}
+	fulfillmentServiceProviderTests(t, fulfillmentServices[0])
+}
+
+func TestFulfillmentServiceProviderGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/fulfillment_services/755357713.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("fulfillment_service.json")))
+
+	fulfillmentService, err := client.FulfillmentServiceProvider.Get(755357713, nil)
+	if err != nil {
+		t.Errorf("FulfillmentServiceProvider.Get returned error: %v", err)
+	}
+
+	fulfillmentServiceProviderTests(t, *fulfillmentService)
+}
+
+func TestFulfillmentServiceProviderCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://"+testHost+"/%s/fulfillment_services.json", client.pathPrefix),
+		httpmock.NewBytesResponder(201, loadFixture("fulfillment_service.json")))
+
+	fulfillmentService := FulfillmentServiceProvider{
+		Name:                   "Jupiter Fulfillment",
+		CallbackURL:            "http://google.com/",
+		Format:                 "json",
+		FulfillmentOrdersOptIn: true,
+		InventoryManagement:    true,
+		TrackingSupport:        true,
+	}
+
+	returnedFulfillmentService, err := client.FulfillmentServiceProvider.Create(fulfillmentService)
+	if err != nil {
+		t.Errorf("FulfillmentServiceProvider.Create returned error: %v", err)
+	}
+
+	fulfillmentServiceProviderTests(t, *returnedFulfillmentService)
+}
+
+func TestFulfillmentServiceProviderUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://"+testHost+"/%s/fulfillment_services/755357713.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("fulfillment_service.json")))
+
+	fulfillmentService := FulfillmentServiceProvider{
+		ID:   755357713,
+		Name: "Jupiter Fulfillment",
+	}
+
+	returnedFulfillmentService, err := client.FulfillmentServiceProvider.Update(fulfillmentService)
+	if err != nil {
+		t.Errorf("FulfillmentServiceProvider.Update returned error: %v", err)
+	}
+
+	fulfillmentServiceProviderTests(t, *returnedFulfillmentService)
+}
+
+func TestFulfillmentServiceProviderDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("https://"+testHost+"/%s/fulfillment_services/755357713.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.FulfillmentServiceProvider.Delete(755357713)
+	if err != nil {
+		t.Errorf("FulfillmentServiceProvider.Delete returned error: %v", err)
+	}
+}
+
+func TestFulfillmentServiceProviderListAssignedFulfillmentOrders(t *testing.T) {
+	setup()
+	defer teardown()
+
+	params := map[string]string{
+		"assignment_status": "fulfillment_requested",
+		"location_ids[]":    "24826418",
+	}
+	httpmock.RegisterResponderWithQuery("GET", fmt.Sprintf("https://"+testHost+"/%s/assigned_fulfillment_orders.json", client.pathPrefix),
+		params, httpmock.NewBytesResponder(200, loadFixture("fulfillment_orders.json")))
+
+	options := AssignedFulfillmentOrderOptions{
+		AssignmentStatus: "fulfillment_requested",
+		LocationIDs:      []int64{24826418},
+	}
+	fulfillmentOrders, err := client.FulfillmentServiceProvider.ListAssignedFulfillmentOrders(options)
+	if err != nil {
+		t.Errorf("FulfillmentServiceProvider.ListAssignedFulfillmentOrders returned error: %v", err)
+	}
+
+	if len(fulfillmentOrders) != 1 {
+		t.Fatalf("FulfillmentServiceProvider.ListAssignedFulfillmentOrders got %d fulfillment orders, expected 1", len(fulfillmentOrders))
+	}
+	fulfillmentOrderTests(t, fulfillmentOrders[0])
+}
+
+func TestFulfillmentServiceProviderRequests(t *testing.T) {
+	setup()
+	defer teardown()
+
+	rejection := FulfillmentRequestRejection{
+		Message: "Not enough inventory on hand to complete the work.",
+		Reason:  "inventory_out_of_stock",
+		LineItems: []FulfillmentRequestRejectionLineItem{
+			{FulfillmentOrderLineItemID: 1025578643, Message: "Not enough inventory."},
+		},
+	}
+
+	cases := []struct {
+		path     string
+		call     func() (*FulfillmentOrder, error)
+		expected map[string]map[string]interface{}
+	}{
+		{
+			"fulfillment_request/accept",
+			func() (*FulfillmentOrder, error) {
+				return client.FulfillmentServiceProvider.AcceptFulfillmentRequest(1046000818, "We will start processing your fulfillment on the next business day.")
+			},
+			map[string]map[string]interface{}{
+				"fulfillment_request": {"message": "We will start processing your fulfillment on the next business day."},
+			},
+		},
+		{
+			"fulfillment_request/reject",
+			func() (*FulfillmentOrder, error) {
+				return client.FulfillmentServiceProvider.RejectFulfillmentRequest(1046000818, rejection)
+			},
+			map[string]map[string]interface{}{
+				"fulfillment_request": {
+					"message": "Not enough inventory on hand to complete the work.",
+					"reason":  "inventory_out_of_stock",
+					"line_items": []interface{}{
+						map[string]interface{}{"fulfillment_order_line_item_id": float64(1025578643), "message": "Not enough inventory."},
+					},
+				},
+			},
+		},
+		{
+			"cancellation_request/accept",
+			func() (*FulfillmentOrder, error) {
+				return client.FulfillmentServiceProvider.AcceptCancellationRequest(1046000818, "Already in the works.")
+			},
+			map[string]map[string]interface{}{
+				"cancellation_request": {"message": "Already in the works."},
+			},
+		},
+		{
+			"cancellation_request/reject",
+			func() (*FulfillmentOrder, error) {
+				return client.FulfillmentServiceProvider.RejectCancellationRequest(1046000818, "")
+			},
+			nil,
+		},
+	}
+
+	for _, c := range cases {
+		var sent map[string]map[string]interface{}
+		httpmock.RegisterResponder("POST", fmt.Sprintf("https://"+testHost+"/%s/fulfillment_orders/1046000818/%s.json", client.pathPrefix, c.path),
+			fulfillmentOrderRequest(&sent))
+
+		fulfillmentOrder, err := c.call()
+		if err != nil {
+			t.Errorf("FulfillmentServiceProvider %s returned error: %v", c.path, err)
+			continue
+		}
+		fulfillmentOrderTests(t, *fulfillmentOrder)
+
+		if !reflect.DeepEqual(sent, c.expected) {
+			t.Errorf("FulfillmentServiceProvider %s sent %+v, expected %+v", c.path, sent, c.expected)
+		}
+	}
+}
+
+type testFulfillmentServiceCallbacks struct {
+	trackingRequest FetchTrackingNumbersRequest
+	stockRequest    FetchStockRequest
+	err             error
+}
+
+func (c *testFulfillmentServiceCallbacks) FetchTrackingNumbers(req FetchTrackingNumbersRequest) (map[string]string, error) {
+	c.trackingRequest = req
+	return map[string]string{"#1001.1": "qwerty", "#1002.1": "asdfg"}, c.err
+}
+
+func (c *testFulfillmentServiceCallbacks) FetchStock(req FetchStockRequest) (map[string]InventoryLevel, error) {
+	c.stockRequest = req
+	return map[string]InventoryLevel{
+		"123":   {Available: PInt(1000)},
+		"sku-2": {},
+	}, c.err
+}
+
+// signedCallbackRequest returns a callback request with the hmac of its
+// query appended, computed like Shopify does
+func signedCallbackRequest(target string, secret string) *http.Request {
+	req := httptest.NewRequest("GET", target, nil)
+	query := req.URL.Query()
+	message, _ := url.QueryUnescape(query.Encode())
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(message))
+	query.Set("hmac", hex.EncodeToString(mac.Sum(nil)))
+	req.URL.RawQuery = query.Encode()
+	return req
+}
+
+func TestFulfillmentServiceHandler(t *testing.T) {
+	setup()
+	defer teardown()
+
+	callbacks := &testFulfillmentServiceCallbacks{}
+	handler := NewFulfillmentServiceHandler(app, callbacks)
+
+	// fetch_tracking_numbers
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, signedCallbackRequest("/callback/fetch_tracking_numbers.json?order_names[]=%231001.1&order_names[]=%231002.1&shop=fooshop.myshopify.com", app.ApiSecret))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("fetch_tracking_numbers returned status %d, expected 200", rec.Code)
+	}
+	expectedTrackingRequest := FetchTrackingNumbersRequest{Shop: "fooshop.myshopify.com", OrderNames: []string{"#1001.1", "#1002.1"}}
+	if !reflect.DeepEqual(callbacks.trackingRequest, expectedTrackingRequest) {
+		t.Errorf("fetch_tracking_numbers called with %+v, expected %+v", callbacks.trackingRequest, expectedTrackingRequest)
+	}
+	var tracking map[string]interface{}
+	if err := json.Unmarshal(rec.Body.Bytes(), &tracking); err != nil {
+		t.Fatal(err)
+	}
+	expectedTracking := map[string]interface{}{
+		"tracking_numbers": map[string]interface{}{"#1001.1": "qwerty", "#1002.1": "asdfg"},
+		"message":          "Successfully received the tracking numbers",
+		"success":          true,
+	}
+	if !reflect.DeepEqual(tracking, expectedTracking) {
+		t.Errorf("fetch_tracking_numbers returned %+v, expected %+v", tracking, expectedTracking)
+	}
+
+	// fetch_stock
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, signedCallbackRequest("/callback/fetch_stock?sku=123&shop=fooshop.myshopify.com&location_id=24826418", app.ApiSecret))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("fetch_stock returned status %d, expected 200", rec.Code)
+	}
+	expectedStockRequest := FetchStockRequest{Shop: "fooshop.myshopify.com", SKU: "123", LocationID: 24826418}
+	if callbacks.stockRequest != expectedStockRequest {
+		t.Errorf("fetch_stock called with %+v, expected %+v", callbacks.stockRequest, expectedStockRequest)
+	}
+	var stock map[string]int
+	if err := json.Unmarshal(rec.Body.Bytes(), &stock); err != nil {
+		t.Fatal(err)
+	}
+	expectedStock := map[string]int{"123": 1000, "sku-2": 0}
+	if !reflect.DeepEqual(stock, expectedStock) {
+		t.Errorf("fetch_stock returned %+v, expected %+v", stock, expectedStock)
+	}
+}
+
+func TestFulfillmentServiceHandlerErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	callbacks := &testFulfillmentServiceCallbacks{}
+	handler := NewFulfillmentServiceHandler(app, callbacks)
+
+	// a signed query replayed for another shop and SKU
+	tampered := signedCallbackRequest("/callback/fetch_stock.json?sku=123&shop=fooshop.myshopify.com", app.ApiSecret)
+	query := tampered.URL.Query()
+	query.Set("sku", "456")
+	query.Set("shop", "barshop.myshopify.com")
+	tampered.URL.RawQuery = query.Encode()
+
+	// the signature of an empty body is the same for every request
+	emptyBodySigned := httptest.NewRequest("GET", "/callback/fetch_stock.json?sku=123", nil)
+	mac := hmac.New(sha256.New, []byte(app.ApiSecret))
+	emptyBodySigned.Header.Set(shopifyChecksumHeader, base64.StdEncoding.EncodeToString(mac.Sum(nil)))
+
+	cases := []struct {
+		req      *http.Request
+		err      error
+		expected int
+	}{
+		{signedCallbackRequest("/callback/fetch_stock.json?sku=123", "wrong secret"), nil, http.StatusUnauthorized},
+		{httptest.NewRequest("GET", "/callback/fetch_stock.json?sku=123", nil), nil, http.StatusUnauthorized},
+		{tampered, nil, http.StatusUnauthorized},
+		{emptyBodySigned, nil, http.StatusUnauthorized},
+		{signedCallbackRequest("/callback/fetch_orders.json", app.ApiSecret), nil, http.StatusNotFound},
+		{signedCallbackRequest("/callback/fetch_stock.json?sku=123", app.ApiSecret), errors.New("warehouse unavailable"), http.StatusInternalServerError},
+	}
+
+	for _, c := range cases {
+		callbacks.err = c.err
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, c.req)
+		if rec.Code != c.expected {
+			t.Errorf("%s returned status %d, expected %d", c.req.URL, rec.Code, c.expected)
+		}
+	}
+}
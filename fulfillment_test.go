@@ -1,7 +1,9 @@
 package goshopify
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"reflect"
 	"testing"
 	"time"
@@ -194,3 +196,83 @@ func TestFulfillmentCancel(t *testing.T) {
 
 	FulfillmentTests(t, *returnedFulfillment)
 }
+
+func TestFulfillmentCreateForFulfillmentOrders(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var sent FulfillmentResource
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://"+testHost+"/%s/fulfillments.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&sent); err != nil {
+				return nil, err
+			}
+			return httpmock.NewBytesResponse(201, loadFixture("fulfillment.json")), nil
+		})
+
+	fulfillment := Fulfillment{
+		LineItemsByFulfillmentOrder: []LineItemsByFulfillmentOrder{
+			{
+				FulfillmentOrderID:        1046000818,
+				FulfillmentOrderLineItems: []FulfillmentOrderLineItem{{ID: 1025578643, Quantity: 1}},
+			},
+		},
+		TrackingInfo:   &FulfillmentTrackingInfo{Number: "123456789", Company: "Bluedart"},
+		NotifyCustomer: true,
+	}
+
+	// the endpoint doesn't depend on the resource of the service
+	fulfillmentService := &FulfillmentServiceOp{client: client, resource: ordersResourceName, resourceID: 123}
+
+	returnedFulfillment, err := fulfillmentService.CreateForFulfillmentOrders(fulfillment)
+	if err != nil {
+		t.Errorf("Fulfillment.CreateForFulfillmentOrders returned error: %v", err)
+	}
+
+	FulfillmentTests(t, *returnedFulfillment)
+
+	if sent.Fulfillment == nil || !reflect.DeepEqual(sent.Fulfillment.LineItemsByFulfillmentOrder, fulfillment.LineItemsByFulfillmentOrder) {
+		t.Errorf("Fulfillment.CreateForFulfillmentOrders sent %+v, expected %+v", sent.Fulfillment, fulfillment)
+	}
+}
+
+func TestFulfillmentUpdateTracking(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var sent map[string]map[string]interface{}
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://"+testHost+"/%s/fulfillments/1022782888/update_tracking.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&sent); err != nil {
+				return nil, err
+			}
+			return httpmock.NewBytesResponse(200, loadFixture("fulfillment.json")), nil
+		})
+
+	trackingInfo := FulfillmentTrackingInfo{
+		Number:  "987654321",
+		URL:     "https://shipping.xyz/track.php?num=987654321",
+		Company: "Bluedart",
+	}
+
+	returnedFulfillment, err := client.Fulfillment.UpdateTracking(1022782888, trackingInfo, false)
+	if err != nil {
+		t.Errorf("Fulfillment.UpdateTracking returned error: %v", err)
+	}
+
+	FulfillmentTests(t, *returnedFulfillment)
+
+	expected := map[string]map[string]interface{}{
+		"fulfillment": {
+			"notify_customer": false,
+			"tracking_info": map[string]interface{}{
+				"number":  "987654321",
+				"url":     "https://shipping.xyz/track.php?num=987654321",
+				"company": "Bluedart",
+			},
+		},
+	}
+	if !reflect.DeepEqual(sent, expected) {
+		t.Errorf("Fulfillment.UpdateTracking sent %+v, expected %+v", sent, expected)
+	}
+}
@@ -0,0 +1,165 @@
+package goshopify
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const giftCardsBasePath = "gift_cards"
+
+// GiftCardService is an interface for interfacing with the gift card endpoints
+// of the Shopify API. Gift cards are only available to Shopify Plus stores.
+// See: https://shopify.dev/docs/api/admin-rest/2023-01/resources/gift-card
+type GiftCardService interface {
+	List(interface{}) ([]GiftCard, error)
+	ListWithPagination(interface{}) ([]GiftCard, *Pagination, error)
+	Count(interface{}) (int, error)
+	Get(int64) (*GiftCard, error)
+	Create(GiftCard) (*GiftCard, error)
+	Update(GiftCard) (*GiftCard, error)
+	Disable(int64) (*GiftCard, error)
+	Search(interface{}) ([]GiftCard, error)
+}
+
+// GiftCardServiceOp handles communication with the gift card related methods
+// of the Shopify API.
+type GiftCardServiceOp struct {
+	client *Client
+}
+
+// GiftCard represents a Shopify gift card. Code is only set when creating a
+// gift card, Shopify returns its LastCharacters afterwards. The code is
+// masked in the logged bodies, except for its last characters.
+type GiftCard struct {
+	ID             int64            `json:"id,omitempty" bson:"id,omitempty"`
+	Code           string           `json:"code,omitempty" bson:"code,omitempty"`
+	LastCharacters string           `json:"last_characters,omitempty" bson:"last_characters,omitempty"`
+	Balance        *decimal.Decimal `json:"balance,omitempty" bson:"balance,omitempty"`
+	InitialValue   *decimal.Decimal `json:"initial_value,omitempty" bson:"initial_value,omitempty"`
+	Currency       string           `json:"currency,omitempty" bson:"currency,omitempty"`
+	CustomerID     int64            `json:"customer_id,omitempty" bson:"customer_id,omitempty"`
+	OrderID        int64            `json:"order_id,omitempty" bson:"order_id,omitempty"`
+	LineItemID     int64            `json:"line_item_id,omitempty" bson:"line_item_id,omitempty"`
+	UserID         int64            `json:"user_id,omitempty" bson:"user_id,omitempty"`
+	APIClientID    int64            `json:"api_client_id,omitempty" bson:"api_client_id,omitempty"`
+	Note           string           `json:"note,omitempty" bson:"note,omitempty"`
+	TemplateSuffix string           `json:"template_suffix,omitempty" bson:"template_suffix,omitempty"`
+	ExpiresOn      string           `json:"expires_on,omitempty" bson:"expires_on,omitempty"`
+	DisabledAt     *time.Time       `json:"disabled_at,omitempty" bson:"disabled_at,omitempty"`
+	CreatedAt      *time.Time       `json:"created_at,omitempty" bson:"created_at,omitempty"`
+	UpdatedAt      *time.Time       `json:"updated_at,omitempty" bson:"updated_at,omitempty"`
+}
+
+// GiftCardListOptions represents the options available when listing gift cards
+type GiftCardListOptions struct {
+	// Status is either "enabled" or "disabled"
+	Status   string `json:"status,omitempty" url:"status,omitempty"`
+	Fields   string `json:"fields,omitempty" url:"fields,omitempty"`
+	Limit    int    `json:"limit,omitempty" url:"limit,omitempty"`
+	SinceID  int64  `json:"since_id,omitempty" url:"since_id,omitempty"`
+	PageInfo string `json:"page_info,omitempty" url:"page_info,omitempty"`
+}
+
+// GiftCardSearchOptions represents the options available when searching for
+// gift cards. Query searches the indexed fields, e.g. "last_characters:mnop",
+// "balance:>10" or "disabled_at:null" for the enabled gift cards.
+type GiftCardSearchOptions struct {
+	Query  string `json:"query,omitempty" url:"query,omitempty"`
+	Order  string `json:"order,omitempty" url:"order,omitempty"`
+	Fields string `json:"fields,omitempty" url:"fields,omitempty"`
+	Limit  int    `json:"limit,omitempty" url:"limit,omitempty"`
+}
+
+// GiftCardResource represents the result from the gift_cards/X.json endpoint
+type GiftCardResource struct {
+	GiftCard *GiftCard `json:"gift_card" bson:"gift_card"`
+}
+
+// GiftCardsResource represents the result from the gift_cards.json endpoint
+type GiftCardsResource struct {
+	GiftCards []GiftCard `json:"gift_cards" bson:"gift_cards"`
+}
+
+// List gift cards
+func (s *GiftCardServiceOp) List(options interface{}) ([]GiftCard, error) {
+	giftCards, _, err := s.ListWithPagination(options)
+	if err != nil {
+		return nil, err
+	}
+	return giftCards, nil
+}
+
+// ListWithPagination lists gift cards and return pagination to retrieve next/previous results.
+func (s *GiftCardServiceOp) ListWithPagination(options interface{}) ([]GiftCard, *Pagination, error) {
+	path := fmt.Sprintf("%s.json", giftCardsBasePath)
+	resource := new(GiftCardsResource)
+	headers := http.Header{}
+
+	headers, err := s.client.createAndDoGetHeaders("GET", path, nil, options, resource)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Extract pagination info from header
+	linkHeader := headers.Get("Link")
+
+	pagination, err := extractPagination(linkHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource.GiftCards, pagination, nil
+}
+
+// Count gift cards
+func (s *GiftCardServiceOp) Count(options interface{}) (int, error) {
+	path := fmt.Sprintf("%s/count.json", giftCardsBasePath)
+	return s.client.Count(path, options)
+}
+
+// Get individual gift card
+func (s *GiftCardServiceOp) Get(giftCardID int64) (*GiftCard, error) {
+	path := fmt.Sprintf("%s/%d.json", giftCardsBasePath, giftCardID)
+	resource := new(GiftCardResource)
+	err := s.client.Get(path, resource, nil)
+	return resource.GiftCard, err
+}
+
+// Create a new gift card. Shopify generates a code if none is given.
+func (s *GiftCardServiceOp) Create(giftCard GiftCard) (*GiftCard, error) {
+	path := fmt.Sprintf("%s.json", giftCardsBasePath)
+	wrappedData := GiftCardResource{GiftCard: &giftCard}
+	resource := new(GiftCardResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.GiftCard, err
+}
+
+// Update an existing gift card. Only the expiry date, note, template suffix
+// and customer can be updated.
+func (s *GiftCardServiceOp) Update(giftCard GiftCard) (*GiftCard, error) {
+	path := fmt.Sprintf("%s/%d.json", giftCardsBasePath, giftCard.ID)
+	wrappedData := GiftCardResource{GiftCard: &giftCard}
+	resource := new(GiftCardResource)
+	err := s.client.Put(path, wrappedData, resource)
+	return resource.GiftCard, err
+}
+
+// Disable a gift card. Disabled gift cards can't be enabled again.
+func (s *GiftCardServiceOp) Disable(giftCardID int64) (*GiftCard, error) {
+	path := fmt.Sprintf("%s/%d/disable.json", giftCardsBasePath, giftCardID)
+	wrappedData := GiftCardResource{GiftCard: &GiftCard{ID: giftCardID}}
+	resource := new(GiftCardResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.GiftCard, err
+}
+
+// Search gift cards
+func (s *GiftCardServiceOp) Search(options interface{}) ([]GiftCard, error) {
+	path := fmt.Sprintf("%s/search.json", giftCardsBasePath)
+	resource := new(GiftCardsResource)
+	err := s.client.Get(path, resource, options)
+	return resource.GiftCards, err
+}
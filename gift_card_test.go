@@ -0,0 +1,230 @@
+package goshopify
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func giftCardTests(t *testing.T, giftCard GiftCard) {
+	// Check that ID is assigned to the returned gift card
+	expectedID := int64(1035197676)
+	if giftCard.ID != expectedID {
+		t.Errorf("GiftCard.ID returned %+v, expected %+v", giftCard.ID, expectedID)
+	}
+
+	expectedBalance := decimal.RequireFromString("25.00")
+	if giftCard.Balance == nil || !giftCard.Balance.Equal(expectedBalance) {
+		t.Errorf("GiftCard.Balance returned %v, expected %v", giftCard.Balance, expectedBalance)
+	}
+
+	expectedInitialValue := decimal.RequireFromString("100.00")
+	if giftCard.InitialValue == nil || !giftCard.InitialValue.Equal(expectedInitialValue) {
+		t.Errorf("GiftCard.InitialValue returned %v, expected %v", giftCard.InitialValue, expectedInitialValue)
+	}
+
+	if giftCard.LastCharacters != "0e0e" {
+		t.Errorf("GiftCard.LastCharacters returned %v, expected 0e0e", giftCard.LastCharacters)
+	}
+}
+
+func TestGiftCardList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponderWithQuery("GET", fmt.Sprintf("https://"+testHost+"/%s/gift_cards.json", client.pathPrefix),
+		map[string]string{"status": "enabled"},
+		httpmock.NewBytesResponder(200, loadFixture("gift_cards.json")))
+
+	giftCards, err := client.GiftCard.List(GiftCardListOptions{Status: "enabled"})
+	if err != nil {
+		t.Errorf("GiftCard.List returned error: %v", err)
+	}
+
+	if len(giftCards) != 1 {
+		t.Fatalf("GiftCard.List got %d gift cards, expected 1", len(giftCards))
+	}
+	giftCardTests(t, giftCards[0])
+}
+
+func TestGiftCardListWithPagination(t *testing.T) {
+	setup()
+	defer teardown()
+
+	response := httpmock.NewBytesResponse(200, loadFixture("gift_cards.json"))
+	response.Header.Set("Link", `<http://valid.url?page_info=foo&limit=2>; rel="next"`)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/gift_cards.json", client.pathPrefix),
+		httpmock.ResponderFromResponse(response))
+
+	giftCards, pagination, err := client.GiftCard.ListWithPagination(GiftCardListOptions{Limit: 2})
+	if err != nil {
+		t.Errorf("GiftCard.ListWithPagination returned error: %v", err)
+	}
+
+	if len(giftCards) != 1 {
+		t.Fatalf("GiftCard.ListWithPagination got %d gift cards, expected 1", len(giftCards))
+	}
+	giftCardTests(t, giftCards[0])
+
+	expectedPagination := &Pagination{
+		NextPageOptions: &ListOptions{PageInfo: PString("foo"), Limit: PInt(2)},
+	}
+	if !reflect.DeepEqual(pagination, expectedPagination) {
+		t.Errorf("GiftCard.ListWithPagination returned pagination %+v, expected %+v", pagination, expectedPagination)
+	}
+}
+
+func TestGiftCardCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/gift_cards/count.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"count": 3}`))
+
+	cnt, err := client.GiftCard.Count(nil)
+	if err != nil {
+		t.Errorf("GiftCard.Count returned error: %v", err)
+	}
+
+	expected := 3
+	if cnt != expected {
+		t.Errorf("GiftCard.Count returned %d, expected %d", cnt, expected)
+	}
+}
+
+func TestGiftCardGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/gift_cards/1035197676.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("gift_card.json")))
+
+	giftCard, err := client.GiftCard.Get(1035197676)
+	if err != nil {
+		t.Errorf("GiftCard.Get returned error: %v", err)
+	}
+
+	giftCardTests(t, *giftCard)
+}
+
+func TestGiftCardCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://"+testHost+"/%s/gift_cards.json", client.pathPrefix),
+		httpmock.NewBytesResponder(201, loadFixture("gift_card.json")))
+
+	initialValue := decimal.RequireFromString("100.00")
+	giftCard := GiftCard{
+		Code:         "abcd efgh ijkl 0e0e",
+		InitialValue: &initialValue,
+	}
+
+	returnedGiftCard, err := client.GiftCard.Create(giftCard)
+	if err != nil {
+		t.Errorf("GiftCard.Create returned error: %v", err)
+	}
+
+	giftCardTests(t, *returnedGiftCard)
+}
+
+func TestGiftCardCreateDoesNotLogCode(t *testing.T) {
+	setup()
+	defer teardown()
+
+	// the code is masked whatever the redactor
+	redactors := []Option{
+		func(c *Client) {},
+		WithRedactor(nil),
+		WithRedactor(&Redactor{Keys: []string{"note"}}),
+	}
+
+	for i, redactor := range redactors {
+		out := &bytes.Buffer{}
+		client = NewClient(app, testShopName, testToken,
+			WithVersion(testApiVersion),
+			WithLogger(&LeveledLogger{Level: LevelDebug, stdoutOverride: out}),
+			redactor)
+		httpmock.ActivateNonDefault(client.Client)
+
+		httpmock.RegisterResponder("POST", fmt.Sprintf("https://"+testHost+"/%s/gift_cards.json", client.pathPrefix),
+			httpmock.NewStringResponder(201, `{"gift_card":{"id":1035197676,"code":"abcdefghijkl0e0e","last_characters":"0e0e"}}`))
+
+		_, err := client.GiftCard.Create(GiftCard{Code: "abcdefghijkl0e0e"})
+		if err != nil {
+			t.Errorf("GiftCard.Create returned error: %v", err)
+		}
+
+		logged := out.String()
+		if strings.Contains(logged, "abcdefghijkl0e0e") {
+			t.Errorf("GiftCard.Create with redactor %d logged the gift card code: %s", i, logged)
+		}
+		if !strings.Contains(logged, `"code":"[REDACTED]0e0e"`) {
+			t.Errorf("GiftCard.Create with redactor %d logged %s, expected the last characters of the code", i, logged)
+		}
+	}
+}
+
+func TestGiftCardUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://"+testHost+"/%s/gift_cards/1035197676.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("gift_card.json")))
+
+	giftCard := GiftCard{
+		ID:        1035197676,
+		ExpiresOn: "2025-01-01",
+	}
+
+	returnedGiftCard, err := client.GiftCard.Update(giftCard)
+	if err != nil {
+		t.Errorf("GiftCard.Update returned error: %v", err)
+	}
+
+	giftCardTests(t, *returnedGiftCard)
+}
+
+func TestGiftCardDisable(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://"+testHost+"/%s/gift_cards/1035197676/disable.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(200, `{"gift_card":{"id":1035197676,"disabled_at":"2023-02-02T09:17:41-05:00"}}`), nil
+		})
+
+	giftCard, err := client.GiftCard.Disable(1035197676)
+	if err != nil {
+		t.Errorf("GiftCard.Disable returned error: %v", err)
+	}
+
+	if giftCard.ID != 1035197676 || giftCard.DisabledAt == nil {
+		t.Errorf("GiftCard.Disable returned %+v, expected a disabled gift card", giftCard)
+	}
+}
+
+func TestGiftCardSearch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponderWithQuery("GET", fmt.Sprintf("https://"+testHost+"/%s/gift_cards/search.json", client.pathPrefix),
+		map[string]string{"query": "last_characters:0e0e"},
+		httpmock.NewBytesResponder(200, loadFixture("gift_cards.json")))
+
+	giftCards, err := client.GiftCard.Search(GiftCardSearchOptions{Query: "last_characters:0e0e"})
+	if err != nil {
+		t.Errorf("GiftCard.Search returned error: %v", err)
+	}
+
+	if len(giftCards) != 1 {
+		t.Fatalf("GiftCard.Search got %d gift cards, expected 1", len(giftCards))
+	}
+	giftCardTests(t, giftCards[0])
+}
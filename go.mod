@@ -6,4 +6,15 @@ require (
 	github.com/google/go-querystring v1.1.0
 	github.com/jarcoal/httpmock v1.2.0
 	github.com/shopspring/decimal v1.3.1
+	go.opentelemetry.io/otel v1.16.0
+	go.opentelemetry.io/otel/metric v1.16.0
+	go.opentelemetry.io/otel/sdk v1.16.0
+	go.opentelemetry.io/otel/sdk/metric v0.39.0
+	go.opentelemetry.io/otel/trace v1.16.0
+)
+
+require (
+	github.com/go-logr/logr v1.2.4 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	golang.org/x/sys v0.8.0 // indirect
 )
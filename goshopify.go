@@ -4,6 +4,7 @@ package goshopify
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -30,6 +31,12 @@ const (
 	defaultApiPathPrefix = "admin"
 	defaultApiVersion    = "stable"
 	defaultHttpTimeout   = 10
+
+	// max number of 303 See Other responses followed for a single request
+	maxSeeOtherRedirects = 10
+
+	// wait between polls when an accepted response has no Retry-After header
+	defaultPollInterval = time.Second
 )
 
 var (
@@ -82,6 +89,16 @@ type Client struct {
 	retries  int
 	attempts int
 
+	// OpenTelemetry instrumentation, nil unless enabled with WithTelemetry
+	telemetry *telemetry
+
+	// masks sensitive values in logged bodies, see WithRedactor
+	redactor *Redactor
+
+	// caches countries, provinces, currencies and policies, nil unless
+	// enabled with WithReferenceCache
+	referenceCache *referenceCache
+
 	RateLimits RateLimitInfo
 
 	// Services used for communicating with the API
@@ -118,7 +135,53 @@ type Client struct {
 	InventoryLevel             InventoryLevelService
 	ShippingZone               ShippingZoneService
 	ProductListing             ProductListingService
+	CollectionListing          CollectionListingService
+	ResourceFeedback           ResourceFeedbackService
 	AccessScopes               AccessScopesService
+	Refund                     RefundService
+	FulfillmentOrder           FulfillmentOrderService
+	FulfillmentServiceProvider FulfillmentServiceProviderService
+	CarrierService             CarrierServiceService
+	GiftCard                   GiftCardService
+	OrderRisk                  OrderRiskService
+	Article                    ArticleService
+	Comment                    CommentService
+	Country                    CountryService
+	Province                   ProvinceService
+	Currency                   CurrencyService
+	Policy                     PolicyService
+	Event                      EventService
+	ShopifyPayments            ShopifyPaymentsService
+	TenderTransaction          TenderTransactionService
+	AbandonedCheckout          AbandonedCheckoutService
+	CustomerSavedSearch        CustomerSavedSearchService
+	MarketingEvent             MarketingEventService
+}
+
+// Sentinel errors matching the status of a failed request. Errors returned
+// by the client wrap them, so they can be checked with errors.Is, e.g.
+// errors.Is(err, ErrNotFound).
+var (
+	ErrUnauthorized    = errors.New("unauthorized")
+	ErrPaymentRequired = errors.New("payment required")
+	ErrForbidden       = errors.New("forbidden")
+	ErrNotFound        = errors.New("not found")
+	ErrUnprocessable   = errors.New("unprocessable entity")
+	ErrLocked          = errors.New("locked")
+
+	// ErrPollTimeout is returned by Client.Poll when the resource is still
+	// being processed after the timeout.
+	ErrPollTimeout = errors.New("timed out polling for resource")
+)
+
+// statusErrors maps response statuses to their sentinel error.
+var statusErrors = map[int]error{
+	http.StatusUnauthorized:        ErrUnauthorized,
+	http.StatusPaymentRequired:     ErrPaymentRequired,
+	http.StatusForbidden:           ErrForbidden,
+	http.StatusNotFound:            ErrNotFound,
+	http.StatusUnprocessableEntity: ErrUnprocessable,
+	http.StatusLocked:              ErrLocked,
 }
 
 // A general response error that follows a similar layout to Shopify's response
@@ -127,6 +190,14 @@ type ResponseError struct {
 	Status  int
 	Message string
 	Errors  []string
+
+	// Fields holds the validation errors per field when Shopify returns
+	// them as an object, e.g. {"errors": {"title": ["can't be blank"]}}.
+	Fields map[string][]string
+
+	// RequestID is the X-Request-Id of the response, which Shopify support
+	// asks for when investigating a failed request.
+	RequestID string
 }
 
 // GetStatus returns http  response status
@@ -144,6 +215,21 @@ func (e ResponseError) GetErrors() []string {
 	return e.Errors
 }
 
+// GetFields returns response validation errors per field
+func (e ResponseError) GetFields() map[string][]string {
+	return e.Fields
+}
+
+// GetRequestID returns the X-Request-Id of the response
+func (e ResponseError) GetRequestID() string {
+	return e.RequestID
+}
+
+// Unwrap returns the sentinel error matching the response status, if any.
+func (e ResponseError) Unwrap() error {
+	return statusErrors[e.Status]
+}
+
 func (e ResponseError) Error() string {
 	if e.Message != "" {
 		return e.Message
@@ -162,15 +248,21 @@ func (e ResponseError) Error() string {
 // ResponseDecodingError occurs when the response body from Shopify could
 // not be parsed.
 type ResponseDecodingError struct {
-	Body    []byte
-	Message string
-	Status  int
+	Body      []byte
+	Message   string
+	Status    int
+	RequestID string
 }
 
 func (e ResponseDecodingError) Error() string {
 	return e.Message
 }
 
+// Unwrap returns the sentinel error matching the response status, if any.
+func (e ResponseDecodingError) Unwrap() error {
+	return statusErrors[e.Status]
+}
+
 // An error specific to a rate-limiting response. Embeds the ResponseError to
 // allow consumers to handle it the same was a normal ResponseError.
 type RateLimitError struct {
@@ -178,6 +270,11 @@ type RateLimitError struct {
 	RetryAfter int
 }
 
+// Unwrap returns the embedded ResponseError, so errors.As can extract it.
+func (e RateLimitError) Unwrap() error {
+	return e.ResponseError
+}
+
 // Creates an API request. A relative URL can be provided in urlStr, which will
 // be resolved to the BaseURL of the Client. Relative URLS should always be
 // specified without a preceding slash. If specified, the value pointed to by
@@ -254,6 +351,7 @@ func NewClient(app App, shopName, token string, opts ...Option) *Client {
 			Timeout: time.Second * defaultHttpTimeout,
 		},
 		log:        &LeveledLogger{},
+		redactor:   NewRedactor(),
 		locker:     sync.Mutex{},
 		app:        app,
 		baseURL:    baseURL,
@@ -295,7 +393,27 @@ func NewClient(app App, shopName, token string, opts ...Option) *Client {
 	c.InventoryLevel = &InventoryLevelServiceOp{client: c}
 	c.ShippingZone = &ShippingZoneServiceOp{client: c}
 	c.ProductListing = &ProductListingServiceOp{client: c}
+	c.CollectionListing = &CollectionListingServiceOp{client: c}
+	c.ResourceFeedback = &ResourceFeedbackServiceOp{client: c}
 	c.AccessScopes = &AccessScopesServiceOp{client: c}
+	c.Refund = &RefundServiceOp{client: c}
+	c.FulfillmentOrder = &FulfillmentOrderServiceOp{client: c}
+	c.FulfillmentServiceProvider = &FulfillmentServiceProviderServiceOp{client: c}
+	c.CarrierService = &CarrierServiceServiceOp{client: c}
+	c.GiftCard = &GiftCardServiceOp{client: c}
+	c.OrderRisk = &OrderRiskServiceOp{client: c}
+	c.Article = &ArticleServiceOp{client: c}
+	c.Comment = &CommentServiceOp{client: c}
+	c.Country = &CountryServiceOp{client: c}
+	c.Province = &ProvinceServiceOp{client: c}
+	c.Currency = &CurrencyServiceOp{client: c}
+	c.Policy = &PolicyServiceOp{client: c}
+	c.Event = &EventServiceOp{client: c}
+	c.ShopifyPayments = &ShopifyPaymentsServiceOp{client: c}
+	c.TenderTransaction = &TenderTransactionServiceOp{client: c}
+	c.AbandonedCheckout = &AbandonedCheckoutServiceOp{client: c}
+	c.CustomerSavedSearch = &CustomerSavedSearchServiceOp{client: c}
+	c.MarketingEvent = &MarketingEventServiceOp{client: c}
 
 	// apply any options
 	for _, opt := range opts {
@@ -319,12 +437,28 @@ func (c *Client) Do(req *http.Request, v interface{}) error {
 
 // doGetHeaders executes a request, decoding the response into `v` and also returns any response headers.
 func (c *Client) doGetHeaders(req *http.Request, v interface{}) (http.Header, error) {
+	resp, err := c.doGetResponse(req, v)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Header, nil
+}
+
+// doGetResponse executes a request, decoding the response into `v`, and
+// returns the final response. Its body has already been closed.
+func (c *Client) doGetResponse(req *http.Request, v interface{}) (_ *http.Response, err error) {
 	c.locker.Lock()
 	defer c.locker.Unlock()
 
+	req, rec := c.telemetry.start(c, req)
+	defer func() { rec.end(c, err) }()
+
 	var resp *http.Response
-	var err error
+	start := time.Now()
+	defer func() { c.logRequestDone(req, resp, time.Since(start), err) }()
+
 	retries := c.retries
+	redirects := 0
 	c.attempts = 0
 	c.logRequest(req)
 
@@ -335,6 +469,20 @@ func (c *Client) doGetHeaders(req *http.Request, v interface{}) (http.Header, er
 		if err != nil {
 			return nil, err // http client errors, not api responses
 		}
+		rec.attempt(resp)
+
+		if resp.StatusCode == http.StatusSeeOther && redirects < maxSeeOtherRedirects {
+			// The response to the request can be found under a different URL in the
+			// Location header and can be retrieved using a GET method on that resource.
+			resp.Body.Close()
+			req, err = seeOtherRequest(req, resp)
+			if err != nil {
+				return nil, err
+			}
+			c.log.Debugf("see other, following %s", req.URL.String())
+			redirects++
+			continue
+		}
 
 		respErr := CheckResponseError(resp)
 		if respErr == nil {
@@ -355,6 +503,7 @@ func (c *Client) doGetHeaders(req *http.Request, v interface{}) (http.Header, er
 			c.log.Debugf("rate limited waiting %s", wait.String())
 			time.Sleep(wait)
 			retries--
+			rec.retry()
 			continue
 		}
 
@@ -367,6 +516,7 @@ func (c *Client) doGetHeaders(req *http.Request, v interface{}) (http.Header, er
 		}
 
 		if doRetry {
+			rec.retry()
 			continue
 		}
 
@@ -374,7 +524,6 @@ func (c *Client) doGetHeaders(req *http.Request, v interface{}) (http.Header, er
 		return nil, respErr
 	}
 
-	c.logResponse(resp)
 	defer resp.Body.Close()
 
 	if c.apiVersion == defaultApiVersion && resp.Header.Get("X-Shopify-API-Version") != "" {
@@ -384,8 +533,16 @@ func (c *Client) doGetHeaders(req *http.Request, v interface{}) (http.Header, er
 	}
 
 	if v != nil {
-		decoder := json.NewDecoder(resp.Body)
-		err := decoder.Decode(&v)
+		decoder := json.NewDecoder(rec.countBody(resp.Body))
+		if stream, ok := v.(*listStream); ok {
+			err = stream.decode(decoder)
+		} else {
+			err = decoder.Decode(&v)
+		}
+		if err == io.EOF && resp.StatusCode == http.StatusAccepted {
+			// accepted for asynchronous processing, there is nothing to decode yet
+			err = nil
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -398,7 +555,47 @@ func (c *Client) doGetHeaders(req *http.Request, v interface{}) (http.Header, er
 
 	c.RateLimits.RetryAfterSeconds, _ = strconv.ParseFloat(resp.Header.Get("Retry-After"), 64)
 
-	return resp.Header, nil
+	return resp, nil
+}
+
+// seeOtherRequest creates the GET request for the Location of a 303 See Other
+// response. Credentials are only passed on to the same host.
+func seeOtherRequest(req *http.Request, resp *http.Response) (*http.Request, error) {
+	location := resp.Header.Get("Location")
+	if location == "" {
+		return nil, ResponseError{
+			Status:    resp.StatusCode,
+			Message:   "See Other response without Location header",
+			RequestID: resp.Header.Get("X-Request-Id"),
+		}
+	}
+
+	return locationRequest(req, location)
+}
+
+// locationRequest creates the GET request for a Location header, resolved
+// against the URL of the request it was a response to. Credentials are only
+// passed on to the same host, so a shop cannot be redirected to send its
+// token elsewhere.
+func locationRequest(req *http.Request, location string) (*http.Request, error) {
+	u, err := req.URL.Parse(location)
+	if err != nil {
+		return nil, err
+	}
+
+	next, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	next = next.WithContext(req.Context())
+	next.Header = req.Header.Clone()
+	next.Header.Del("Content-Type")
+	if u.Host != req.URL.Host {
+		next.Header.Del("X-Shopify-Access-Token")
+		next.Header.Del("Authorization")
+	}
+
+	return next, nil
 }
 
 func (c *Client) logRequest(req *http.Request) {
@@ -406,7 +603,7 @@ func (c *Client) logRequest(req *http.Request) {
 		return
 	}
 	if req.URL != nil {
-		c.log.Debugf("%s: %s", req.Method, req.URL.String())
+		c.log.Debugf("%s: %s", req.Method, c.redactor.redactString(req.URL.String()))
 	}
 	c.logBody(&req.Body, "SENT: %s")
 }
@@ -419,18 +616,52 @@ func (c *Client) logResponse(res *http.Response) {
 	c.logBody(&res.Body, "RESP: %s")
 }
 
+// logRequestDone passes a structured record of the request to the logger, if
+// it implements RequestLoggerInterface.
+func (c *Client) logRequestDone(req *http.Request, resp *http.Response, duration time.Duration, err error) {
+	logger, ok := c.log.(RequestLoggerInterface)
+	if !ok {
+		return
+	}
+
+	entry := RequestLog{
+		Shop:     c.baseURL.Host,
+		Method:   req.Method,
+		URL:      c.redactor.redactString(req.URL.String()),
+		Duration: duration,
+		Attempts: c.attempts,
+		Err:      err,
+	}
+	if resp != nil {
+		entry.Status = resp.StatusCode
+		entry.RequestID = resp.Header.Get("X-Request-Id")
+	}
+	logger.LogRequest(entry)
+}
+
+// debugEnabled reports whether the logger emits debug messages. Loggers that
+// do not implement DebugEnabledInterface are assumed to.
+func (c *Client) debugEnabled() bool {
+	if l, ok := c.log.(DebugEnabledInterface); ok {
+		return l.DebugEnabled()
+	}
+	return true
+}
+
 func (c *Client) logBody(body *io.ReadCloser, format string) {
-	if body == nil {
+	if body == nil || !c.debugEnabled() {
 		return
 	}
 	b, _ := ioutil.ReadAll(*body)
 	if len(b) > 0 {
-		c.log.Debugf(format, string(b))
+		c.log.Debugf(format, string(c.redactor.Redact(b)))
 	}
 	*body = ioutil.NopCloser(bytes.NewBuffer(b))
 }
 
 func wrapSpecificError(r *http.Response, err ResponseError) error {
+	err.RequestID = r.Header.Get("X-Request-Id")
+
 	// see https://www.shopify.dev/concepts/about-apis/response-codes
 	if err.Status == http.StatusTooManyRequests {
 		f, _ := strconv.ParseFloat(r.Header.Get("Retry-After"), 64)
@@ -440,11 +671,8 @@ func wrapSpecificError(r *http.Response, err ResponseError) error {
 		}
 	}
 
-	// if err.Status == http.StatusSeeOther {
-	// todo
-	// The response to the request can be found under a different URL in the
-	// Location header and can be retrieved using a GET method on that resource.
-	// }
+	// http.StatusSeeOther is followed by doGetResponse, it only ends up here
+	// once too many redirects were followed.
 
 	if err.Status == http.StatusNotAcceptable {
 		err.Message = http.StatusText(err.Status)
@@ -475,9 +703,10 @@ func CheckResponseError(r *http.Response) error {
 		err := json.Unmarshal(bodyBytes, &shopifyError)
 		if err != nil {
 			return ResponseDecodingError{
-				Body:    bodyBytes,
-				Message: err.Error(),
-				Status:  r.StatusCode,
+				Body:      bodyBytes,
+				Message:   err.Error(),
+				Status:    r.StatusCode,
+				RequestID: r.Header.Get("X-Request-Id"),
 			}
 		}
 	}
@@ -501,7 +730,8 @@ func CheckResponseError(r *http.Response) error {
 	//     ]
 	//   }
 	// }
-	// This structure is flattened to a single array:
+	// This structure is kept per field in Fields and flattened to a single
+	// array:
 	// [ "title: something is wrong" ]
 	//
 	// Unfortunately, "errors" can also be a single string so we have to deal
@@ -520,6 +750,7 @@ func CheckResponseError(r *http.Response) error {
 	case reflect.Map:
 		// A map, parse each error for each key in the map.
 		// json always serializes into map[string]interface{} for objects
+		responseError.Fields = make(map[string][]string)
 		for k, v := range shopifyError.Errors.(map[string]interface{}) {
 			switch reflect.TypeOf(v).Kind() {
 			// Check to make sure the interface is a slice
@@ -533,6 +764,7 @@ func CheckResponseError(r *http.Response) error {
 					}
 					topicAndElem := fmt.Sprintf("%v: %v", k, elem)
 					responseError.Errors = append(responseError.Errors, topicAndElem)
+					responseError.Fields[k] = append(responseError.Fields[k], fmt.Sprint(elem))
 				}
 			case reflect.String:
 				elem := v.(string)
@@ -541,6 +773,7 @@ func CheckResponseError(r *http.Response) error {
 				}
 				topicAndElem := fmt.Sprintf("%v: %v", k, elem)
 				responseError.Errors = append(responseError.Errors, topicAndElem)
+				responseError.Fields[k] = append(responseError.Fields[k], elem)
 			}
 		}
 	}
@@ -548,6 +781,71 @@ func CheckResponseError(r *http.Response) error {
 	return wrapSpecificError(r, responseError)
 }
 
+// listStream decodes a list response element by element instead of into a
+// slice. It is passed as the resource to createAndDoGetHeaders.
+type listStream struct {
+	// key of the array in the response object, e.g. "orders"
+	key string
+
+	// each decodes the next array element from the decoder
+	each func(*json.Decoder) error
+}
+
+// decode walks the response object, calling each for every element of the
+// array under key and skipping all other values.
+func (s *listStream) decode(decoder *json.Decoder) error {
+	if err := expectDelim(decoder, '{'); err != nil {
+		return err
+	}
+
+	for decoder.More() {
+		t, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+
+		if key, _ := t.(string); key != s.key {
+			var skip json.RawMessage
+			if err := decoder.Decode(&skip); err != nil {
+				return err
+			}
+			continue
+		}
+
+		t, err = decoder.Token()
+		if err != nil {
+			return err
+		}
+		if t == nil {
+			continue // null list
+		}
+		if delim, ok := t.(json.Delim); !ok || delim != '[' {
+			return fmt.Errorf("expected array for %q, got %v", s.key, t)
+		}
+		for decoder.More() {
+			if err := s.each(decoder); err != nil {
+				return err
+			}
+		}
+		if err := expectDelim(decoder, ']'); err != nil {
+			return err
+		}
+	}
+
+	return expectDelim(decoder, '}')
+}
+
+func expectDelim(decoder *json.Decoder, expected json.Delim) error {
+	t, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := t.(json.Delim); !ok || delim != expected {
+		return fmt.Errorf("expected %v in response, got %v", expected, t)
+	}
+	return nil
+}
+
 // General list options that can be used for most collections of entities.
 type ListOptions struct {
 
@@ -640,3 +938,48 @@ func (c *Client) Put(path string, data, resource interface{}) error {
 func (c *Client) Delete(path string) error {
 	return c.CreateAndDo("DELETE", path, nil, nil, nil)
 }
+
+// Poll performs GET requests for the given path until Shopify stops
+// responding with 202 Accepted, i.e. the asynchronous job behind the resource
+// is done, and saves the result in the given resource. When an accepted
+// response has a Location header, it is polled next, without credentials when
+// it is on another host. Between requests Poll waits for the duration of the
+// Retry-After header, or a second when it is missing, invalid or not
+// positive, and gives up with ErrPollTimeout when the resource is not ready
+// within timeout.
+func (c *Client) Poll(relPath string, resource interface{}, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+
+	relPath = path.Join(c.pathPrefix, strings.TrimLeft(relPath, "/"))
+	req, err := c.NewRequest("GET", relPath, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := c.doGetResponse(req, resource)
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode != http.StatusAccepted {
+			return nil
+		}
+
+		wait := defaultPollInterval
+		if seconds, err := strconv.ParseFloat(resp.Header.Get("Retry-After"), 64); err == nil && seconds > 0 {
+			wait = time.Duration(seconds * float64(time.Second))
+		}
+		if time.Now().Add(wait).After(deadline) {
+			return ErrPollTimeout
+		}
+
+		if location := resp.Header.Get("Location"); location != "" {
+			if req, err = locationRequest(req, location); err != nil {
+				return err
+			}
+		}
+
+		c.log.Debugf("accepted, polling again in %s", wait.String())
+		time.Sleep(wait)
+	}
+}
@@ -287,7 +287,7 @@ func TestDo(t *testing.T) {
 		{
 			"foo/3",
 			httpmock.NewStringResponder(400, `{"errors": {"title": ["wrong"]}}`),
-			ResponseError{Status: 400, Message: "title: wrong", Errors: []string{"title: wrong"}},
+			ResponseError{Status: 400, Message: "title: wrong", Errors: []string{"title: wrong"}, Fields: map[string][]string{"title": {"wrong"}}},
 		},
 		{
 			"foo/4",
@@ -770,6 +770,243 @@ func TestCheckResponseError(t *testing.T) {
 	}
 }
 
+func TestCheckResponseErrorDetails(t *testing.T) {
+	resp := httpmock.NewStringResponse(422, `{"errors": {"title": ["can't be blank", "is too short"], "handle": "is taken"}}`)
+	resp.Header.Set("X-Request-Id", "c4f3b1e2-8a7d-4f4e-9d0c-3b2a1f0e9d8c")
+
+	err := CheckResponseError(resp)
+
+	var respErr ResponseError
+	if !errors.As(err, &respErr) {
+		t.Fatalf("CheckResponseError(): expected ResponseError, actual %#v", err)
+	}
+
+	expectedFields := map[string][]string{
+		"title":  {"can't be blank", "is too short"},
+		"handle": {"is taken"},
+	}
+	if !reflect.DeepEqual(respErr.GetFields(), expectedFields) {
+		t.Errorf("ResponseError.Fields: expected %v, actual %v", expectedFields, respErr.GetFields())
+	}
+	if respErr.GetRequestID() != "c4f3b1e2-8a7d-4f4e-9d0c-3b2a1f0e9d8c" {
+		t.Errorf("ResponseError.RequestID: expected %s, actual %s", "c4f3b1e2-8a7d-4f4e-9d0c-3b2a1f0e9d8c", respErr.GetRequestID())
+	}
+	if !errors.Is(err, ErrUnprocessable) {
+		t.Errorf("CheckResponseError(): expected %v to be ErrUnprocessable", err)
+	}
+}
+
+func TestResponseErrorIs(t *testing.T) {
+	cases := []struct {
+		resp     *http.Response
+		expected error
+	}{
+		{httpmock.NewStringResponse(401, `{"errors": "[API] Invalid API key or access token"}`), ErrUnauthorized},
+		{httpmock.NewStringResponse(402, `{"errors": "Unavailable Shop"}`), ErrPaymentRequired},
+		{httpmock.NewStringResponse(403, ``), ErrForbidden},
+		{httpmock.NewStringResponse(404, `{"errors": "Not Found"}`), ErrNotFound},
+		{httpmock.NewStringResponse(404, `<html></html>`), ErrNotFound},
+		{httpmock.NewStringResponse(422, `{"errors": ["invalid"]}`), ErrUnprocessable},
+		{httpmock.NewStringResponse(423, `{"errors": "This shop is unavailable"}`), ErrLocked},
+		{httpmock.NewStringResponse(500, `{"errors": "Internal Server Error"}`), nil},
+	}
+
+	sentinels := []error{ErrUnauthorized, ErrPaymentRequired, ErrForbidden, ErrNotFound, ErrUnprocessable, ErrLocked}
+	for _, c := range cases {
+		err := CheckResponseError(c.resp)
+		for _, sentinel := range sentinels {
+			if errors.Is(err, sentinel) != (sentinel == c.expected) {
+				t.Errorf("errors.Is(%#v, %v): expected %v", err, sentinel, sentinel == c.expected)
+			}
+		}
+	}
+
+	// rate limit errors still expose the embedded ResponseError
+	resp := httpmock.NewStringResponse(429, `{"errors": "Exceeded 2 calls per second for api client."}`)
+	resp.Header.Set("Retry-After", "2.0")
+	var respErr ResponseError
+	if err := CheckResponseError(resp); !errors.As(err, &respErr) || respErr.Status != 429 {
+		t.Errorf("errors.As(%#v, ResponseError): expected status 429", err)
+	}
+}
+
+func TestDoSeeOther(t *testing.T) {
+	setup()
+	defer teardown()
+
+	// let the client handle redirects instead of the http.Client
+	client.Client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+	client.token = "abcd"
+
+	type MyStruct struct {
+		Foo string `json:"foo"`
+	}
+
+	httpmock.RegisterResponder("POST", testUrl("foo/1"), func(req *http.Request) (*http.Response, error) {
+		resp := httpmock.NewStringResponse(http.StatusSeeOther, "")
+		resp.Header.Set("Location", "/foo/2")
+		return resp, nil
+	})
+	httpmock.RegisterResponder("GET", testUrl("foo/2"), func(req *http.Request) (*http.Response, error) {
+		if req.Header.Get("X-Shopify-Access-Token") != "abcd" {
+			return httpmock.NewStringResponse(http.StatusUnauthorized, ""), nil
+		}
+		return httpmock.NewStringResponse(http.StatusOK, `{"foo": "bar"}`), nil
+	})
+
+	req, err := client.NewRequest("POST", "foo/1", map[string]string{"foo": "bar"}, nil)
+	if err != nil {
+		t.Fatal("error creating request: ", err)
+	}
+
+	body := new(MyStruct)
+	err = client.Do(req, body)
+	if err != nil {
+		t.Errorf("Do(): returned error %v", err)
+	}
+	if body.Foo != "bar" {
+		t.Errorf("Do(): expected %#v, actual %#v", &MyStruct{Foo: "bar"}, body)
+	}
+
+	// credentials are not passed on to other hosts
+	httpmock.RegisterResponder("GET", "https://example.com/foo/3", func(req *http.Request) (*http.Response, error) {
+		if req.Header.Get("X-Shopify-Access-Token") != "" {
+			return httpmock.NewStringResponse(http.StatusBadRequest, `{"errors": "token leaked"}`), nil
+		}
+		return httpmock.NewStringResponse(http.StatusOK, `{"foo": "baz"}`), nil
+	})
+	httpmock.RegisterResponder("GET", testUrl("foo/3"), func(req *http.Request) (*http.Response, error) {
+		resp := httpmock.NewStringResponse(http.StatusSeeOther, "")
+		resp.Header.Set("Location", "https://example.com/foo/3")
+		return resp, nil
+	})
+
+	req, _ = client.NewRequest("GET", "foo/3", nil, nil)
+	err = client.Do(req, body)
+	if err != nil || body.Foo != "baz" {
+		t.Errorf("Do(): expected %#v, actual %#v, error %v", &MyStruct{Foo: "baz"}, body, err)
+	}
+
+	// missing Location and redirect loops return an error
+	httpmock.RegisterResponder("GET", testUrl("foo/4"), httpmock.NewStringResponder(http.StatusSeeOther, ""))
+	httpmock.RegisterResponder("GET", testUrl("foo/5"), func(req *http.Request) (*http.Response, error) {
+		resp := httpmock.NewStringResponse(http.StatusSeeOther, "")
+		resp.Header.Set("Location", "/foo/5")
+		return resp, nil
+	})
+
+	for _, relPath := range []string{"foo/4", "foo/5"} {
+		req, _ = client.NewRequest("GET", relPath, nil, nil)
+		err = client.Do(req, body)
+		var respErr ResponseError
+		if !errors.As(err, &respErr) || respErr.Status != http.StatusSeeOther {
+			t.Errorf("Do(%s): expected See Other ResponseError, actual %#v", relPath, err)
+		}
+	}
+}
+
+func TestPoll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	type MyStruct struct {
+		Foo string `json:"foo"`
+	}
+
+	polls := 0
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/foo/1.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			polls++
+			resp := httpmock.NewStringResponse(http.StatusAccepted, "")
+			resp.Header.Set("Retry-After", "0.01")
+			resp.Header.Set("Location", fmt.Sprintf("/%s/foo/2.json", client.pathPrefix))
+			return resp, nil
+		})
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/foo/2.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			polls++
+			if polls < 3 {
+				resp := httpmock.NewStringResponse(http.StatusAccepted, "")
+				resp.Header.Set("Retry-After", "0.01")
+				return resp, nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, `{"foo": "bar"}`), nil
+		})
+
+	body := new(MyStruct)
+	err := client.Poll("foo/1.json", body, time.Second)
+	if err != nil {
+		t.Errorf("Client.Poll returned error: %v", err)
+	}
+	if body.Foo != "bar" || polls != 3 {
+		t.Errorf("Client.Poll returned %#v after %d polls, expected %#v after 3", body, polls, &MyStruct{Foo: "bar"})
+	}
+
+	// resources that are not ready in time
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/foo/3.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(http.StatusAccepted, "")
+			resp.Header.Set("Retry-After", "5")
+			return resp, nil
+		})
+
+	err = client.Poll("foo/3.json", body, time.Second)
+	if err != ErrPollTimeout {
+		t.Errorf("Client.Poll returned error %v, expected %v", err, ErrPollTimeout)
+	}
+
+	// invalid and zero Retry-After headers wait for the default interval
+	for _, retryAfter := range []string{"0", "-1", "soon"} {
+		httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/foo/4.json", client.pathPrefix),
+			func(req *http.Request) (*http.Response, error) {
+				resp := httpmock.NewStringResponse(http.StatusAccepted, "")
+				resp.Header.Set("Retry-After", retryAfter)
+				return resp, nil
+			})
+
+		err = client.Poll("foo/4.json", body, defaultPollInterval/2)
+		if err != ErrPollTimeout {
+			t.Errorf("Client.Poll with Retry-After %q returned error %v, expected %v", retryAfter, err, ErrPollTimeout)
+		}
+	}
+}
+
+func TestPollOtherHost(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.token = "abcd"
+
+	type MyStruct struct {
+		Foo string `json:"foo"`
+	}
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/foo/1.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(http.StatusAccepted, "")
+			resp.Header.Set("Retry-After", "0.01")
+			resp.Header.Set("Location", "https://example.com/foo/2.json")
+			return resp, nil
+		})
+	httpmock.RegisterResponder("GET", "https://example.com/foo/2.json", func(req *http.Request) (*http.Response, error) {
+		if req.Header.Get("X-Shopify-Access-Token") != "" {
+			return httpmock.NewStringResponse(http.StatusBadRequest, `{"errors": "token leaked"}`), nil
+		}
+		return httpmock.NewStringResponse(http.StatusOK, `{"foo": "bar"}`), nil
+	})
+
+	body := new(MyStruct)
+	err := client.Poll("foo/1.json", body, time.Second)
+	if err != nil {
+		t.Errorf("Client.Poll returned error: %v", err)
+	}
+	if body.Foo != "bar" {
+		t.Errorf("Client.Poll returned %#v, expected %#v", body, &MyStruct{Foo: "bar"})
+	}
+}
+
 func TestCount(t *testing.T) {
 	setup()
 	defer teardown()
@@ -2,6 +2,7 @@ package goshopify
 
 import (
 	"fmt"
+	"net/http"
 	"time"
 )
 
@@ -19,10 +20,12 @@ type InventoryLevelListOptions struct {
 // See https://help.shopify.com/en/api/reference/inventory/inventorylevel
 type InventoryLevelService interface {
 	List(interface{}) ([]InventoryLevel, error)
+	ListWithPagination(interface{}) ([]InventoryLevel, *Pagination, error)
 	Adjust(adjust InventoryLevelAdjust) (*InventoryLevel, error)
 	Connect(connect InventoryLevelConnect) (*InventoryLevel, error)
 	Set(level InventoryLevel) (*InventoryLevel, error)
 	Delete(connect InventoryLevelConnect) error
+	Reconcile(map[InventoryLevelKey]int, InventoryReconcileOptions) ([]InventoryReconcileResult, error)
 }
 
 // InventoryLevelServiceOp is the default implementation of the InventoryLevelService interface
@@ -62,10 +65,33 @@ type InventoryLevelsResource struct {
 
 // List inventory levels
 func (s *InventoryLevelServiceOp) List(options interface{}) ([]InventoryLevel, error) {
+	levels, _, err := s.ListWithPagination(options)
+	if err != nil {
+		return nil, err
+	}
+	return levels, nil
+}
+
+// ListWithPagination lists inventory levels and return pagination to retrieve next/previous results.
+func (s *InventoryLevelServiceOp) ListWithPagination(options interface{}) ([]InventoryLevel, *Pagination, error) {
 	path := fmt.Sprintf("%s.json", inventoryLevelsBasePath)
 	resource := new(InventoryLevelsResource)
-	err := s.client.Get(path, resource, options)
-	return resource.InventoryLevels, err
+	headers := http.Header{}
+
+	headers, err := s.client.createAndDoGetHeaders("GET", path, nil, options, resource)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Extract pagination info from header
+	linkHeader := headers.Get("Link")
+
+	pagination, err := extractPagination(linkHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource.InventoryLevels, pagination, nil
 }
 
 // Adjust the inventory level of an inventory item at a location
@@ -0,0 +1,287 @@
+package goshopify
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// inventoryReconcileChunkSize is the number of inventory item IDs listed
+	// per request
+	inventoryReconcileChunkSize = 50
+
+	// inventoryReconcilePageSize is the number of inventory levels listed per
+	// page
+	inventoryReconcilePageSize = 250
+
+	defaultInventoryReconcileRateLimitRetries = 5
+
+	// inventoryReconcileRetryAfter is the wait after a rate limited call
+	// without Retry-After header
+	inventoryReconcileRetryAfter = time.Second
+)
+
+// inventoryReconcileSleep waits between the calls of Reconcile, replaced by
+// tests
+var inventoryReconcileSleep = time.Sleep
+
+// InventoryLevelKey identifies the inventory level of an inventory item at a
+// location
+type InventoryLevelKey struct {
+	InventoryItemID int64
+	LocationID      int64
+}
+
+// InventoryReconcileAction is the call made to reconcile an inventory level
+type InventoryReconcileAction string
+
+// Calls made to reconcile an inventory level
+const (
+	// InventoryReconcileActionNone is used for levels already as desired
+	InventoryReconcileActionNone InventoryReconcileAction = "none"
+
+	// InventoryReconcileActionSet is used for levels set with Set, which
+	// connects the inventory item to the location when needed
+	InventoryReconcileActionSet InventoryReconcileAction = "set"
+
+	// InventoryReconcileActionAdjust is used for levels changed with Adjust
+	InventoryReconcileActionAdjust InventoryReconcileAction = "adjust"
+
+	// InventoryReconcileActionConnect is used for missing levels which should
+	// have nothing available, as connecting creates them so
+	InventoryReconcileActionConnect InventoryReconcileAction = "connect"
+)
+
+// InventoryReconcileOptions represents the options of reconciling inventory
+// levels
+type InventoryReconcileOptions struct {
+	// RateLimitRetries is the number of times a rate limited call is
+	// retried after waiting for its Retry-After, 5 by default. Use a negative
+	// number to not retry.
+	RateLimitRetries int
+
+	// Adjust changes existing levels by the difference between the desired
+	// and the listed availability instead of setting them, so sales made
+	// since listing are not overwritten.
+	Adjust bool
+}
+
+// InventoryReconcileResult represents the outcome of reconciling an inventory
+// level. Previous is nil when the level did not exist or had no available
+// quantity.
+type InventoryReconcileResult struct {
+	InventoryLevelKey
+	Action    InventoryReconcileAction
+	Previous  *int
+	Available int
+	Err       error
+}
+
+// Reconcile brings the inventory levels to the desired available quantity per
+// inventory item and location. It lists the current levels, computes the
+// calls needed, one at most per level, and makes them one after the other:
+// the client holds its lock for the whole of a request, so concurrent calls
+// would only queue behind each other and no worker pool is used. Between
+// calls it waits for the leaky bucket of the API to drain when it fills up,
+// and rate limited calls are retried after their Retry-After. It returns a
+// result per desired level, sorted by inventory item then location, whose Err
+// is the error of its call. The returned error is only set when listing the
+// current levels failed, in which case no call is made.
+func (s *InventoryLevelServiceOp) Reconcile(desired map[InventoryLevelKey]int, options InventoryReconcileOptions) ([]InventoryReconcileResult, error) {
+	throttle := &inventoryReconcileThrottle{
+		client:  s.client,
+		retries: options.RateLimitRetries,
+	}
+	if throttle.retries == 0 {
+		throttle.retries = defaultInventoryReconcileRateLimitRetries
+	}
+
+	current, err := s.listReconciled(desired, throttle)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]InventoryReconcileResult, 0, len(desired))
+	for key, available := range desired {
+		result := InventoryReconcileResult{
+			InventoryLevelKey: key,
+			Action:            InventoryReconcileActionNone,
+			Available:         available,
+		}
+
+		level, exists := current[key]
+		if exists {
+			result.Previous = level.Available
+		}
+		switch {
+		case !exists && available == 0:
+			result.Action = InventoryReconcileActionConnect
+		case !exists || level.Available == nil:
+			result.Action = InventoryReconcileActionSet
+		case *level.Available != available && options.Adjust:
+			result.Action = InventoryReconcileActionAdjust
+		case *level.Available != available:
+			result.Action = InventoryReconcileActionSet
+		}
+		results = append(results, result)
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].InventoryItemID != results[j].InventoryItemID {
+			return results[i].InventoryItemID < results[j].InventoryItemID
+		}
+		return results[i].LocationID < results[j].LocationID
+	})
+
+	for i := range results {
+		if results[i].Action == InventoryReconcileActionNone {
+			continue
+		}
+		result := results[i]
+		results[i].Err = throttle.do(func() error { return s.reconcile(result) })
+	}
+
+	return results, nil
+}
+
+// reconcile makes the call of the result's action
+func (s *InventoryLevelServiceOp) reconcile(result InventoryReconcileResult) error {
+	var err error
+	switch result.Action {
+	case InventoryReconcileActionConnect:
+		_, err = s.Connect(InventoryLevelConnect{
+			InventoryItemID: result.InventoryItemID,
+			LocationID:      result.LocationID,
+		})
+	case InventoryReconcileActionAdjust:
+		_, err = s.Adjust(InventoryLevelAdjust{
+			InventoryItemID:     result.InventoryItemID,
+			LocationID:          result.LocationID,
+			AvailableAdjustment: result.Available - *result.Previous,
+		})
+	case InventoryReconcileActionSet:
+		available := result.Available
+		_, err = s.Set(InventoryLevel{
+			InventoryItemID: result.InventoryItemID,
+			LocationID:      result.LocationID,
+			Available:       &available,
+		})
+	}
+	return err
+}
+
+// listReconciled lists the current inventory levels of the desired inventory
+// items at the desired locations, in chunks of inventory item IDs
+func (s *InventoryLevelServiceOp) listReconciled(desired map[InventoryLevelKey]int, throttle *inventoryReconcileThrottle) (map[InventoryLevelKey]InventoryLevel, error) {
+	itemIDs := map[int64]bool{}
+	locationIDs := map[int64]bool{}
+	for key := range desired {
+		itemIDs[key.InventoryItemID] = true
+		locationIDs[key.LocationID] = true
+	}
+	sortedItemIDs := sortedIDs(itemIDs)
+	locations := joinIDs(sortedIDs(locationIDs))
+
+	current := map[InventoryLevelKey]InventoryLevel{}
+	for start := 0; start < len(sortedItemIDs); start += inventoryReconcileChunkSize {
+		end := start + inventoryReconcileChunkSize
+		if end > len(sortedItemIDs) {
+			end = len(sortedItemIDs)
+		}
+
+		items := joinIDs(sortedItemIDs[start:end])
+		var options interface{} = InventoryLevelListOptions{
+			InventoryItemIds: &items,
+			LocationIds:      &locations,
+			Limit:            PInt(inventoryReconcilePageSize),
+		}
+		for options != nil {
+			var levels []InventoryLevel
+			var pagination *Pagination
+			err := throttle.do(func() (err error) {
+				levels, pagination, err = s.ListWithPagination(options)
+				return err
+			})
+			if err != nil {
+				return nil, err
+			}
+			for _, level := range levels {
+				current[InventoryLevelKey{InventoryItemID: level.InventoryItemID, LocationID: level.LocationID}] = level
+			}
+
+			options = nil
+			if pagination != nil && pagination.NextPageOptions != nil {
+				options = pagination.NextPageOptions
+			}
+		}
+	}
+	return current, nil
+}
+
+// inventoryReconcileThrottle paces the calls of Reconcile to the rate limits
+// of the API
+type inventoryReconcileThrottle struct {
+	client  *Client
+	retries int
+}
+
+// do makes a call, retrying it when it is rate limited, then waits for the
+// leaky bucket to drain if the call filled it up beyond three quarters
+func (t *inventoryReconcileThrottle) do(call func() error) error {
+	for attempt := 0; ; attempt++ {
+		err := call()
+
+		var rateLimitErr RateLimitError
+		if errors.As(err, &rateLimitErr) && attempt < t.retries {
+			wait := time.Duration(rateLimitErr.RetryAfter) * time.Second
+			if wait <= 0 {
+				wait = inventoryReconcileRetryAfter
+			}
+			inventoryReconcileSleep(wait)
+			continue
+		}
+
+		if err == nil {
+			t.drain()
+		}
+		return err
+	}
+}
+
+// drain waits until the bucket is filled to three quarters at most. Shopify
+// leaks a twentieth of the bucket per second, e.g. 2 of 40 calls.
+func (t *inventoryReconcileThrottle) drain() {
+	t.client.locker.Lock()
+	limits := t.client.RateLimits
+	t.client.locker.Unlock()
+
+	if limits.BucketSize <= 0 {
+		return
+	}
+	excess := limits.RequestCount - limits.BucketSize*3/4
+	if excess <= 0 {
+		return
+	}
+	leakRate := float64(limits.BucketSize) / 20
+	inventoryReconcileSleep(time.Duration(float64(excess) / leakRate * float64(time.Second)))
+}
+
+func sortedIDs(ids map[int64]bool) []int64 {
+	sorted := make([]int64, 0, len(ids))
+	for id := range ids {
+		sorted = append(sorted, id)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted
+}
+
+func joinIDs(ids []int64) string {
+	s := make([]string, len(ids))
+	for i, id := range ids {
+		s[i] = strconv.FormatInt(id, 10)
+	}
+	return strings.Join(s, ",")
+}
@@ -0,0 +1,363 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func TestInventoryLevelListWithPagination(t *testing.T) {
+	setup()
+	defer teardown()
+
+	response := httpmock.NewStringResponse(200, `{"inventory_levels": [{"inventory_item_id": 808950810, "location_id": 487838322, "available": 9}]}`)
+	response.Header.Set("Link", `<http://valid.url?page_info=foo&limit=1>; rel="next"`)
+	httpmock.RegisterResponderWithQuery("GET", fmt.Sprintf("https://"+testHost+"/%s/inventory_levels.json", client.pathPrefix),
+		map[string]string{"location_ids": "487838322", "limit": "1"},
+		httpmock.ResponderFromResponse(response))
+
+	locationIDs := "487838322"
+	levels, pagination, err := client.InventoryLevel.ListWithPagination(InventoryLevelListOptions{LocationIds: &locationIDs, Limit: PInt(1)})
+	if err != nil {
+		t.Errorf("InventoryLevel.ListWithPagination returned error: %v", err)
+	}
+
+	expected := []InventoryLevel{{InventoryItemID: 808950810, LocationID: 487838322, Available: PInt(9)}}
+	if !reflect.DeepEqual(levels, expected) {
+		t.Errorf("InventoryLevel.ListWithPagination returned %+v, expected %+v", levels, expected)
+	}
+
+	expectedPagination := &Pagination{
+		NextPageOptions: &ListOptions{PageInfo: PString("foo"), Limit: PInt(1)},
+	}
+	if !reflect.DeepEqual(pagination, expectedPagination) {
+		t.Errorf("InventoryLevel.ListWithPagination returned pagination %+v, expected %+v", pagination, expectedPagination)
+	}
+}
+
+// inventoryLevelsMock serves the inventory levels it holds like Shopify,
+// listing at most 2 per page, and records the calls changing them. The page
+// info of the next page is its offset in the results of the last query.
+type inventoryLevelsMock struct {
+	mu        sync.Mutex
+	levels    map[InventoryLevelKey]InventoryLevel
+	lastQuery url.Values
+	lists     int
+	calls     []string
+	fail      InventoryLevelKey
+}
+
+func (m *inventoryLevelsMock) register(t *testing.T) {
+	base := fmt.Sprintf("https://"+testHost+"/%s/inventory_levels", client.pathPrefix)
+
+	httpmock.RegisterResponder("GET", base+".json", func(req *http.Request) (*http.Response, error) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		m.lists++
+
+		query := req.URL.Query()
+		offset, _ := strconv.Atoi(query.Get("page_info"))
+		if offset > 0 {
+			query = m.lastQuery
+		}
+		m.lastQuery = query
+
+		items := strings.Split(query.Get("inventory_item_ids"), ",")
+		if len(items) > inventoryReconcileChunkSize {
+			t.Errorf("listed %d inventory items, expected at most %d", len(items), inventoryReconcileChunkSize)
+		}
+
+		var matching []InventoryLevel
+		for _, item := range items {
+			for _, location := range strings.Split(query.Get("location_ids"), ",") {
+				itemID, _ := strconv.ParseInt(item, 10, 64)
+				locationID, _ := strconv.ParseInt(location, 10, 64)
+				if level, ok := m.levels[InventoryLevelKey{InventoryItemID: itemID, LocationID: locationID}]; ok {
+					matching = append(matching, level)
+				}
+			}
+		}
+
+		resource := InventoryLevelsResource{InventoryLevels: []InventoryLevel{}}
+		for i := offset; i < len(matching) && i < offset+2; i++ {
+			resource.InventoryLevels = append(resource.InventoryLevels, matching[i])
+		}
+		response, err := httpmock.NewJsonResponse(200, resource)
+		if offset+2 < len(matching) {
+			response.Header.Set("Link", fmt.Sprintf(`<http://valid.url?page_info=%d&limit=250>; rel="next"`, offset+2))
+		}
+		return response, err
+	})
+
+	for _, action := range []string{"set", "adjust", "connect"} {
+		action := action
+		httpmock.RegisterResponder("POST", base+"/"+action+".json", func(req *http.Request) (*http.Response, error) {
+			var body struct {
+				InventoryItemID     int64 `json:"inventory_item_id"`
+				LocationID          int64 `json:"location_id"`
+				Available           *int  `json:"available"`
+				AvailableAdjustment int   `json:"available_adjustment"`
+			}
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				return nil, err
+			}
+
+			m.mu.Lock()
+			defer m.mu.Unlock()
+			key := InventoryLevelKey{InventoryItemID: body.InventoryItemID, LocationID: body.LocationID}
+			if key == m.fail {
+				return httpmock.NewStringResponse(422, `{"errors": ["Inventory item does not have inventory tracking enabled"]}`), nil
+			}
+
+			level := m.levels[key]
+			level.InventoryItemID, level.LocationID = key.InventoryItemID, key.LocationID
+			switch action {
+			case "set":
+				level.Available = body.Available
+				m.calls = append(m.calls, fmt.Sprintf("set %d/%d to %d", key.InventoryItemID, key.LocationID, *body.Available))
+			case "adjust":
+				available := *level.Available + body.AvailableAdjustment
+				level.Available = &available
+				m.calls = append(m.calls, fmt.Sprintf("adjust %d/%d by %d", key.InventoryItemID, key.LocationID, body.AvailableAdjustment))
+			case "connect":
+				level.Available = PInt(0)
+				m.calls = append(m.calls, fmt.Sprintf("connect %d/%d", key.InventoryItemID, key.LocationID))
+			}
+			m.levels[key] = level
+			return httpmock.NewJsonResponse(200, InventoryLevelResource{InventoryLevel: &level})
+		})
+	}
+}
+
+func TestInventoryLevelReconcile(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mock := &inventoryLevelsMock{
+		levels: map[InventoryLevelKey]InventoryLevel{
+			{InventoryItemID: 1, LocationID: 10}: {InventoryItemID: 1, LocationID: 10, Available: PInt(5)},
+			{InventoryItemID: 1, LocationID: 20}: {InventoryItemID: 1, LocationID: 20, Available: PInt(3)},
+			{InventoryItemID: 2, LocationID: 10}: {InventoryItemID: 2, LocationID: 10, Available: PInt(7)},
+			{InventoryItemID: 2, LocationID: 30}: {InventoryItemID: 2, LocationID: 30, Available: PInt(1)},
+		},
+		fail: InventoryLevelKey{InventoryItemID: 3, LocationID: 10},
+	}
+	mock.register(t)
+
+	desired := map[InventoryLevelKey]int{
+		{InventoryItemID: 1, LocationID: 10}: 5,
+		{InventoryItemID: 1, LocationID: 20}: 8,
+		{InventoryItemID: 2, LocationID: 10}: 4,
+		{InventoryItemID: 2, LocationID: 20}: 0,
+		{InventoryItemID: 2, LocationID: 30}: 6,
+		{InventoryItemID: 3, LocationID: 10}: 2,
+	}
+	results, err := client.InventoryLevel.Reconcile(desired, InventoryReconcileOptions{})
+	if err != nil {
+		t.Fatalf("InventoryLevel.Reconcile returned error: %v", err)
+	}
+
+	// 4 levels listed 2 per page
+	if mock.lists != 2 {
+		t.Errorf("InventoryLevel.Reconcile listed %d pages, expected 2", mock.lists)
+	}
+
+	type outcome struct {
+		key      InventoryLevelKey
+		action   InventoryReconcileAction
+		previous *int
+		failed   bool
+	}
+	expected := []outcome{
+		{InventoryLevelKey{1, 10}, InventoryReconcileActionNone, PInt(5), false},
+		{InventoryLevelKey{1, 20}, InventoryReconcileActionSet, PInt(3), false},
+		{InventoryLevelKey{2, 10}, InventoryReconcileActionSet, PInt(7), false},
+		{InventoryLevelKey{2, 20}, InventoryReconcileActionConnect, nil, false},
+		{InventoryLevelKey{2, 30}, InventoryReconcileActionSet, PInt(1), false},
+		{InventoryLevelKey{3, 10}, InventoryReconcileActionSet, nil, true},
+	}
+	if len(results) != len(expected) {
+		t.Fatalf("InventoryLevel.Reconcile returned %d results, expected %d", len(results), len(expected))
+	}
+	for i, e := range expected {
+		r := results[i]
+		if r.InventoryLevelKey != e.key || r.Action != e.action || !reflect.DeepEqual(r.Previous, e.previous) || (r.Err != nil) != e.failed {
+			t.Errorf("InventoryLevel.Reconcile result %d is %+v, expected %+v", i, r, e)
+		}
+		if r.Available != desired[e.key] {
+			t.Errorf("InventoryLevel.Reconcile result %d has available %d, expected %d", i, r.Available, desired[e.key])
+		}
+	}
+
+	for key, available := range desired {
+		if key == mock.fail {
+			continue
+		}
+		level := mock.levels[key]
+		if level.Available == nil || *level.Available != available {
+			t.Errorf("InventoryLevel.Reconcile left %+v at %v, expected %d", key, level.Available, available)
+		}
+	}
+	if len(mock.calls) != 4 {
+		t.Errorf("InventoryLevel.Reconcile made calls %v, expected 4", mock.calls)
+	}
+}
+
+func TestInventoryLevelReconcileAdjust(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mock := &inventoryLevelsMock{
+		levels: map[InventoryLevelKey]InventoryLevel{
+			{InventoryItemID: 1, LocationID: 10}: {InventoryItemID: 1, LocationID: 10, Available: PInt(5)},
+		},
+	}
+	mock.register(t)
+
+	desired := map[InventoryLevelKey]int{
+		{InventoryItemID: 1, LocationID: 10}: 2,
+		{InventoryItemID: 1, LocationID: 20}: 4,
+	}
+	results, err := client.InventoryLevel.Reconcile(desired, InventoryReconcileOptions{Adjust: true})
+	if err != nil {
+		t.Fatalf("InventoryLevel.Reconcile returned error: %v", err)
+	}
+
+	if len(results) != 2 || results[0].Action != InventoryReconcileActionAdjust || results[1].Action != InventoryReconcileActionSet {
+		t.Errorf("InventoryLevel.Reconcile returned %+v, expected an adjust then a set", results)
+	}
+
+	expectedCalls := map[string]bool{"adjust 1/10 by -3": true, "set 1/20 to 4": true}
+	if len(mock.calls) != 2 || !expectedCalls[mock.calls[0]] || !expectedCalls[mock.calls[1]] {
+		t.Errorf("InventoryLevel.Reconcile made calls %v, expected %v", mock.calls, expectedCalls)
+	}
+}
+
+func TestInventoryLevelReconcileChunks(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mock := &inventoryLevelsMock{levels: map[InventoryLevelKey]InventoryLevel{}}
+	mock.register(t)
+
+	desired := map[InventoryLevelKey]int{}
+	for item := int64(1); item <= 120; item++ {
+		key := InventoryLevelKey{InventoryItemID: item, LocationID: 10}
+		mock.levels[key] = InventoryLevel{InventoryItemID: item, LocationID: 10, Available: PInt(1)}
+		desired[key] = 1
+	}
+
+	results, err := client.InventoryLevel.Reconcile(desired, InventoryReconcileOptions{})
+	if err != nil {
+		t.Fatalf("InventoryLevel.Reconcile returned error: %v", err)
+	}
+
+	for _, result := range results {
+		if result.Action != InventoryReconcileActionNone {
+			t.Errorf("InventoryLevel.Reconcile returned %+v, expected no call", result)
+		}
+	}
+	if len(mock.calls) != 0 {
+		t.Errorf("InventoryLevel.Reconcile made calls %v, expected none", mock.calls)
+	}
+}
+
+func TestInventoryLevelReconcileRateLimited(t *testing.T) {
+	setup()
+	defer teardown()
+
+	// Without WithRetry the client returns rate limited calls to Reconcile
+	client = NewClient(app, testShopName, testToken, WithVersion(testApiVersion))
+	httpmock.ActivateNonDefault(client.Client)
+
+	var waits []time.Duration
+	inventoryReconcileSleep = func(d time.Duration) { waits = append(waits, d) }
+	defer func() { inventoryReconcileSleep = time.Sleep }()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/inventory_levels.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"inventory_levels": [
+			{"inventory_item_id": 1, "location_id": 10, "available": 0},
+			{"inventory_item_id": 2, "location_id": 10, "available": 0},
+			{"inventory_item_id": 3, "location_id": 10, "available": 0}
+		]}`))
+
+	// The first set fills the bucket, the second is rate limited once
+	sets := 0
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://"+testHost+"/%s/inventory_levels/set.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			sets++
+			response := httpmock.NewStringResponse(200, `{"inventory_level": {}}`)
+			switch sets {
+			case 1:
+				response.Header.Set("X-Shopify-Shop-Api-Call-Limit", "32/40")
+			case 2:
+				response = httpmock.NewStringResponse(429, `{"errors": "Exceeded 2 calls per second for api client."}`)
+				response.Header.Set("Retry-After", "2.0")
+			default:
+				response.Header.Set("X-Shopify-Shop-Api-Call-Limit", "10/40")
+			}
+			return response, nil
+		})
+
+	desired := map[InventoryLevelKey]int{
+		{InventoryItemID: 1, LocationID: 10}: 5,
+		{InventoryItemID: 2, LocationID: 10}: 5,
+		{InventoryItemID: 3, LocationID: 10}: 5,
+	}
+	results, err := client.InventoryLevel.Reconcile(desired, InventoryReconcileOptions{})
+	if err != nil {
+		t.Fatalf("InventoryLevel.Reconcile returned error: %v", err)
+	}
+
+	for _, result := range results {
+		if result.Action != InventoryReconcileActionSet || result.Err != nil {
+			t.Errorf("InventoryLevel.Reconcile returned %+v, expected a successful set", result)
+		}
+	}
+	if sets != 4 {
+		t.Errorf("InventoryLevel.Reconcile made %d sets, expected 4", sets)
+	}
+
+	// 2 calls over three quarters of the bucket leak in 1s, then Retry-After
+	expectedWaits := []time.Duration{time.Second, 2 * time.Second}
+	if !reflect.DeepEqual(waits, expectedWaits) {
+		t.Errorf("InventoryLevel.Reconcile waited %v, expected %v", waits, expectedWaits)
+	}
+
+	// Without retries the rate limited call fails
+	sets, waits = 1, nil
+	results, err = client.InventoryLevel.Reconcile(desired, InventoryReconcileOptions{RateLimitRetries: -1})
+	if err != nil {
+		t.Fatalf("InventoryLevel.Reconcile returned error: %v", err)
+	}
+	var rateLimitErr RateLimitError
+	if !errors.As(results[0].Err, &rateLimitErr) || results[1].Err != nil || results[2].Err != nil {
+		t.Errorf("InventoryLevel.Reconcile returned %+v, expected the first set to be rate limited", results)
+	}
+	if len(waits) != 0 {
+		t.Errorf("InventoryLevel.Reconcile waited %v, expected no wait", waits)
+	}
+}
+
+func TestInventoryLevelReconcileListError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/inventory_levels.json", client.pathPrefix),
+		httpmock.NewStringResponder(404, `{"errors": "Not Found"}`))
+
+	results, err := client.InventoryLevel.Reconcile(map[InventoryLevelKey]int{{InventoryItemID: 1, LocationID: 10}: 1}, InventoryReconcileOptions{})
+	if err == nil || results != nil {
+		t.Errorf("InventoryLevel.Reconcile returned %+v, expected an error", results)
+	}
+}
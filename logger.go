@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"time"
 )
 
 // idea from https://github.com/stripe/stripe-go/blob/master/log.go
@@ -22,6 +23,32 @@ type LeveledLoggerInterface interface {
 	Warnf(format string, v ...interface{})
 }
 
+// RequestLog describes a completed call to the Shopify API.
+type RequestLog struct {
+	Shop      string
+	Method    string
+	URL       string
+	Status    int
+	Duration  time.Duration
+	Attempts  int
+	RequestID string // value of the X-Request-Id response header
+	Err       error
+}
+
+// RequestLoggerInterface can optionally be implemented by a
+// LeveledLoggerInterface to receive a structured record of every request
+// made by the client, in addition to the printf style debug messages.
+type RequestLoggerInterface interface {
+	LogRequest(entry RequestLog)
+}
+
+// DebugEnabledInterface can optionally be implemented by a
+// LeveledLoggerInterface to report whether debug messages are emitted.
+// Request and response bodies are only read for logging when they are.
+type DebugEnabledInterface interface {
+	DebugEnabled() bool
+}
+
 // It prints warnings and errors to `os.Stderr` and other messages to
 // `os.Stdout`.
 type LeveledLogger struct {
@@ -46,6 +73,11 @@ func (l *LeveledLogger) Debugf(format string, v ...interface{}) {
 	}
 }
 
+// DebugEnabled reports whether debug messages are emitted.
+func (l *LeveledLogger) DebugEnabled() bool {
+	return l.Level >= LevelDebug
+}
+
 // Errorf logs a warning message using Printf conventions.
 func (l *LeveledLogger) Errorf(format string, v ...interface{}) {
 	// Infof logs a debug message using Printf conventions.
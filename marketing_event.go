@@ -0,0 +1,259 @@
+package goshopify
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const marketingEventsBasePath = "marketing_events"
+
+// MarketingEventType is the type of a marketing event
+type MarketingEventType string
+
+// Types of marketing events
+const (
+	MarketingEventTypeAd            MarketingEventType = "ad"
+	MarketingEventTypePost          MarketingEventType = "post"
+	MarketingEventTypeMessage       MarketingEventType = "message"
+	MarketingEventTypeRetargeting   MarketingEventType = "retargeting"
+	MarketingEventTypeTransactional MarketingEventType = "transactional"
+	MarketingEventTypeAffiliate     MarketingEventType = "affiliate"
+	MarketingEventTypeLoyalty       MarketingEventType = "loyalty"
+	MarketingEventTypeNewsletter    MarketingEventType = "newsletter"
+	MarketingEventTypeAbandonedCart MarketingEventType = "abandoned_cart"
+)
+
+// MarketingChannel is the channel a marketing event runs on
+type MarketingChannel string
+
+// Marketing channels
+const (
+	MarketingChannelSearch   MarketingChannel = "search"
+	MarketingChannelDisplay  MarketingChannel = "display"
+	MarketingChannelSocial   MarketingChannel = "social"
+	MarketingChannelEmail    MarketingChannel = "email"
+	MarketingChannelReferral MarketingChannel = "referral"
+)
+
+var marketingEventTypes = map[MarketingEventType]bool{
+	MarketingEventTypeAd:            true,
+	MarketingEventTypePost:          true,
+	MarketingEventTypeMessage:       true,
+	MarketingEventTypeRetargeting:   true,
+	MarketingEventTypeTransactional: true,
+	MarketingEventTypeAffiliate:     true,
+	MarketingEventTypeLoyalty:       true,
+	MarketingEventTypeNewsletter:    true,
+	MarketingEventTypeAbandonedCart: true,
+}
+
+var marketingChannels = map[MarketingChannel]bool{
+	MarketingChannelSearch:   true,
+	MarketingChannelDisplay:  true,
+	MarketingChannelSocial:   true,
+	MarketingChannelEmail:    true,
+	MarketingChannelReferral: true,
+}
+
+// MarketingEventService is an interface for interfacing with the marketing
+// event endpoints of the Shopify API.
+// See: https://shopify.dev/docs/api/admin-rest/2023-01/resources/marketingevent
+type MarketingEventService interface {
+	List(interface{}) ([]MarketingEvent, error)
+	Count(interface{}) (int, error)
+	Get(int64, interface{}) (*MarketingEvent, error)
+	Create(MarketingEvent) (*MarketingEvent, error)
+	Update(MarketingEvent) (*MarketingEvent, error)
+	Delete(int64) error
+	CreateEngagements(int64, []MarketingEventEngagement) ([]MarketingEventEngagement, error)
+}
+
+// MarketingEventServiceOp handles communication with the marketing event
+// related methods of the Shopify API.
+type MarketingEventServiceOp struct {
+	client *Client
+}
+
+// MarketingEvent represents a marketing campaign run by an app, reported in
+// the Marketing section of the Shopify admin
+type MarketingEvent struct {
+	ID                int64              `json:"id,omitempty" bson:"id,omitempty"`
+	EventType         MarketingEventType `json:"event_type,omitempty" bson:"event_type,omitempty"`
+	MarketingChannel  MarketingChannel   `json:"marketing_channel,omitempty" bson:"marketing_channel,omitempty"`
+	Paid              bool               `json:"paid,omitempty" bson:"paid,omitempty"`
+	RemoteID          string             `json:"remote_id,omitempty" bson:"remote_id,omitempty"`
+	Description       string             `json:"description,omitempty" bson:"description,omitempty"`
+	ReferringDomain   string             `json:"referring_domain,omitempty" bson:"referring_domain,omitempty"`
+	ManageURL         string             `json:"manage_url,omitempty" bson:"manage_url,omitempty"`
+	PreviewURL        string             `json:"preview_url,omitempty" bson:"preview_url,omitempty"`
+	UTMCampaign       string             `json:"utm_campaign,omitempty" bson:"utm_campaign,omitempty"`
+	UTMSource         string             `json:"utm_source,omitempty" bson:"utm_source,omitempty"`
+	UTMMedium         string             `json:"utm_medium,omitempty" bson:"utm_medium,omitempty"`
+	Budget            *decimal.Decimal   `json:"budget,omitempty" bson:"budget,omitempty"`
+	BudgetType        string             `json:"budget_type,omitempty" bson:"budget_type,omitempty"`
+	Currency          string             `json:"currency,omitempty" bson:"currency,omitempty"`
+	BreadcrumbID      string             `json:"breadcrumb_id,omitempty" bson:"breadcrumb_id,omitempty"`
+	MarketedResources []MarketedResource `json:"marketed_resources,omitempty" bson:"marketed_resources,omitempty"`
+	StartedAt         *time.Time         `json:"started_at,omitempty" bson:"started_at,omitempty"`
+	EndedAt           *time.Time         `json:"ended_at,omitempty" bson:"ended_at,omitempty"`
+	ScheduledToEndAt  *time.Time         `json:"scheduled_to_end_at,omitempty" bson:"scheduled_to_end_at,omitempty"`
+}
+
+// MarketedResource represents a resource promoted by a marketing event, e.g.
+// a product. Type is one of "product", "collection", "price_rule", "page",
+// "article" or "homepage".
+type MarketedResource struct {
+	Type string `json:"type,omitempty" bson:"type,omitempty"`
+	ID   int64  `json:"id,omitempty" bson:"id,omitempty"`
+}
+
+// MarketingEventEngagement represents the customer interactions with a
+// marketing event during a day. OccurredOn is formatted as YYYY-MM-DD.
+type MarketingEventEngagement struct {
+	OccurredOn        string           `json:"occurred_on,omitempty" bson:"occurred_on,omitempty"`
+	ViewsCount        int              `json:"views_count,omitempty" bson:"views_count,omitempty"`
+	UniqueViewsCount  int              `json:"unique_views_count,omitempty" bson:"unique_views_count,omitempty"`
+	ImpressionsCount  int              `json:"impressions_count,omitempty" bson:"impressions_count,omitempty"`
+	ClicksCount       int              `json:"clicks_count,omitempty" bson:"clicks_count,omitempty"`
+	UniqueClicksCount int              `json:"unique_clicks_count,omitempty" bson:"unique_clicks_count,omitempty"`
+	FavoritesCount    int              `json:"favorites_count,omitempty" bson:"favorites_count,omitempty"`
+	CommentsCount     int              `json:"comments_count,omitempty" bson:"comments_count,omitempty"`
+	SharesCount       int              `json:"shares_count,omitempty" bson:"shares_count,omitempty"`
+	AdSpend           *decimal.Decimal `json:"ad_spend,omitempty" bson:"ad_spend,omitempty"`
+	IsCumulative      bool             `json:"is_cumulative,omitempty" bson:"is_cumulative,omitempty"`
+	UTCOffset         string           `json:"utc_offset,omitempty" bson:"utc_offset,omitempty"`
+	FetchedAt         *time.Time       `json:"fetched_at,omitempty" bson:"fetched_at,omitempty"`
+}
+
+// MarketingEventResource represents the result from the marketing_events/X.json endpoint
+type MarketingEventResource struct {
+	MarketingEvent *MarketingEvent `json:"marketing_event" bson:"marketing_event"`
+}
+
+// MarketingEventsResource represents the result from the marketing_events.json endpoint
+type MarketingEventsResource struct {
+	MarketingEvents []MarketingEvent `json:"marketing_events" bson:"marketing_events"`
+}
+
+// MarketingEventEngagementsResource represents the result from the marketing_events/X/engagements.json endpoint
+type MarketingEventEngagementsResource struct {
+	Engagements []MarketingEventEngagement `json:"engagements" bson:"engagements"`
+}
+
+// Validate reports whether the marketing event can be created: its type,
+// channel and start are set, its UTM parameters are set without surrounding
+// whitespace, and a budget comes with its currency and type.
+func (e MarketingEvent) Validate() error {
+	if !marketingEventTypes[e.EventType] {
+		return fmt.Errorf("marketing event has an invalid event type %q", e.EventType)
+	}
+	if !marketingChannels[e.MarketingChannel] {
+		return fmt.Errorf("marketing event has an invalid marketing channel %q", e.MarketingChannel)
+	}
+	if e.StartedAt == nil {
+		return errors.New("marketing event is missing started at")
+	}
+	if e.EndedAt != nil && e.EndedAt.Before(*e.StartedAt) {
+		return errors.New("marketing event has an end before its start")
+	}
+
+	utm := []struct{ name, value string }{
+		{"utm_campaign", e.UTMCampaign},
+		{"utm_source", e.UTMSource},
+		{"utm_medium", e.UTMMedium},
+	}
+	var missing []string
+	for _, param := range utm {
+		if param.value == "" {
+			missing = append(missing, param.name)
+		} else if strings.TrimSpace(param.value) != param.value {
+			return fmt.Errorf("marketing event has surrounding whitespace in %s", param.name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("marketing event is missing %s", strings.Join(missing, ", "))
+	}
+
+	if e.Budget != nil {
+		if e.Currency == "" {
+			return errors.New("marketing event has a budget without currency")
+		}
+		if e.BudgetType != "daily" && e.BudgetType != "lifetime" {
+			return fmt.Errorf("marketing event has an invalid budget type %q", e.BudgetType)
+		}
+	}
+	return nil
+}
+
+// List marketing events
+func (s *MarketingEventServiceOp) List(options interface{}) ([]MarketingEvent, error) {
+	path := fmt.Sprintf("%s.json", marketingEventsBasePath)
+	resource := new(MarketingEventsResource)
+	err := s.client.Get(path, resource, options)
+	return resource.MarketingEvents, err
+}
+
+// Count marketing events
+func (s *MarketingEventServiceOp) Count(options interface{}) (int, error) {
+	path := fmt.Sprintf("%s/count.json", marketingEventsBasePath)
+	return s.client.Count(path, options)
+}
+
+// Get individual marketing event
+func (s *MarketingEventServiceOp) Get(eventID int64, options interface{}) (*MarketingEvent, error) {
+	path := fmt.Sprintf("%s/%d.json", marketingEventsBasePath, eventID)
+	resource := new(MarketingEventResource)
+	err := s.client.Get(path, resource, options)
+	return resource.MarketingEvent, err
+}
+
+// Create a new marketing event, after checking it with Validate
+func (s *MarketingEventServiceOp) Create(event MarketingEvent) (*MarketingEvent, error) {
+	if err := event.Validate(); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("%s.json", marketingEventsBasePath)
+	wrappedData := MarketingEventResource{MarketingEvent: &event}
+	resource := new(MarketingEventResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.MarketingEvent, err
+}
+
+// Update an existing marketing event, after checking it with Validate
+func (s *MarketingEventServiceOp) Update(event MarketingEvent) (*MarketingEvent, error) {
+	if err := event.Validate(); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("%s/%d.json", marketingEventsBasePath, event.ID)
+	wrappedData := MarketingEventResource{MarketingEvent: &event}
+	resource := new(MarketingEventResource)
+	err := s.client.Put(path, wrappedData, resource)
+	return resource.MarketingEvent, err
+}
+
+// Delete an existing marketing event
+func (s *MarketingEventServiceOp) Delete(eventID int64) error {
+	return s.client.Delete(fmt.Sprintf("%s/%d.json", marketingEventsBasePath, eventID))
+}
+
+// CreateEngagements reports a batch of engagements of a marketing event, at
+// most one per day
+func (s *MarketingEventServiceOp) CreateEngagements(eventID int64, engagements []MarketingEventEngagement) ([]MarketingEventEngagement, error) {
+	for i, engagement := range engagements {
+		if _, err := time.Parse("2006-01-02", engagement.OccurredOn); err != nil {
+			return nil, fmt.Errorf("marketing event engagement at index %d has an invalid occurred on %q", i, engagement.OccurredOn)
+		}
+	}
+
+	path := fmt.Sprintf("%s/%d/engagements.json", marketingEventsBasePath, eventID)
+	wrappedData := MarketingEventEngagementsResource{Engagements: engagements}
+	resource := new(MarketingEventEngagementsResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.Engagements, err
+}
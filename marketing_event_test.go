@@ -0,0 +1,228 @@
+package goshopify
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func marketingEventTests(t *testing.T, event MarketingEvent) {
+	// Check that ID is assigned to the returned event
+	expectedID := int64(998730532)
+	if event.ID != expectedID {
+		t.Errorf("MarketingEvent.ID returned %+v, expected %+v", event.ID, expectedID)
+	}
+
+	if event.EventType != MarketingEventTypeAd || event.MarketingChannel != MarketingChannelSocial {
+		t.Errorf("MarketingEvent returned %s on %s, expected %s on %s", event.EventType, event.MarketingChannel, MarketingEventTypeAd, MarketingChannelSocial)
+	}
+
+	expectedBudget := decimal.RequireFromString("10.11")
+	if event.Budget == nil || !event.Budget.Equal(expectedBudget) {
+		t.Errorf("MarketingEvent.Budget returned %v, expected %v", event.Budget, expectedBudget)
+	}
+
+	expectedResources := []MarketedResource{{Type: "product", ID: 632910392}}
+	if !reflect.DeepEqual(event.MarketedResources, expectedResources) {
+		t.Errorf("MarketingEvent.MarketedResources returned %+v, expected %+v", event.MarketedResources, expectedResources)
+	}
+}
+
+func validMarketingEvent() MarketingEvent {
+	startedAt := time.Date(2022, time.December, 15, 5, 0, 0, 0, time.UTC)
+	budget := decimal.RequireFromString("10.11")
+	return MarketingEvent{
+		EventType:        MarketingEventTypeAd,
+		MarketingChannel: MarketingChannelSocial,
+		StartedAt:        &startedAt,
+		UTMCampaign:      "1234567890",
+		UTMSource:        "facebook",
+		UTMMedium:        "cpc",
+		Budget:           &budget,
+		BudgetType:       "daily",
+		Currency:         "GBP",
+	}
+}
+
+func TestMarketingEventValidate(t *testing.T) {
+	before := time.Date(2022, time.December, 14, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		description string
+		change      func(*MarketingEvent)
+		valid       bool
+	}{
+		{"valid", func(*MarketingEvent) {}, true},
+		{"no budget", func(e *MarketingEvent) { e.Budget, e.BudgetType, e.Currency = nil, "", "" }, true},
+		{"unknown event type", func(e *MarketingEvent) { e.EventType = "billboard" }, false},
+		{"unknown channel", func(e *MarketingEvent) { e.MarketingChannel = "" }, false},
+		{"no start", func(e *MarketingEvent) { e.StartedAt = nil }, false},
+		{"end before start", func(e *MarketingEvent) { e.EndedAt = &before }, false},
+		{"missing utm source", func(e *MarketingEvent) { e.UTMSource = "" }, false},
+		{"utm campaign with whitespace", func(e *MarketingEvent) { e.UTMCampaign = " 1234567890" }, false},
+		{"budget without currency", func(e *MarketingEvent) { e.Currency = "" }, false},
+		{"unknown budget type", func(e *MarketingEvent) { e.BudgetType = "weekly" }, false},
+	}
+
+	for _, c := range cases {
+		event := validMarketingEvent()
+		c.change(&event)
+		err := event.Validate()
+		if c.valid && err != nil {
+			t.Errorf("MarketingEvent.Validate returned error for %s: %v", c.description, err)
+		}
+		if !c.valid && err == nil {
+			t.Errorf("MarketingEvent.Validate returned no error for %s", c.description)
+		}
+	}
+}
+
+func TestMarketingEventList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/marketing_events.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("marketing_events.json")))
+
+	events, err := client.MarketingEvent.List(nil)
+	if err != nil {
+		t.Errorf("MarketingEvent.List returned error: %v", err)
+	}
+
+	if len(events) != 1 {
+		t.Fatalf("MarketingEvent.List got %d events, expected 1", len(events))
+	}
+	marketingEventTests(t, events[0])
+}
+
+func TestMarketingEventCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/marketing_events/count.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"count": 2}`))
+
+	cnt, err := client.MarketingEvent.Count(nil)
+	if err != nil {
+		t.Errorf("MarketingEvent.Count returned error: %v", err)
+	}
+
+	expected := 2
+	if cnt != expected {
+		t.Errorf("MarketingEvent.Count returned %d, expected %d", cnt, expected)
+	}
+}
+
+func TestMarketingEventGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/marketing_events/998730532.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("marketing_event.json")))
+
+	event, err := client.MarketingEvent.Get(998730532, nil)
+	if err != nil {
+		t.Errorf("MarketingEvent.Get returned error: %v", err)
+	}
+
+	marketingEventTests(t, *event)
+}
+
+func TestMarketingEventCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://"+testHost+"/%s/marketing_events.json", client.pathPrefix),
+		httpmock.NewBytesResponder(201, loadFixture("marketing_event.json")))
+
+	event, err := client.MarketingEvent.Create(validMarketingEvent())
+	if err != nil {
+		t.Fatalf("MarketingEvent.Create returned error: %v", err)
+	}
+	marketingEventTests(t, *event)
+
+	invalid := validMarketingEvent()
+	invalid.UTMMedium = ""
+	_, err = client.MarketingEvent.Create(invalid)
+	if err == nil {
+		t.Errorf("MarketingEvent.Create returned no error for an event without utm_medium")
+	}
+
+	info := httpmock.GetCallCountInfo()
+	if calls := info[fmt.Sprintf("POST https://"+testHost+"/%s/marketing_events.json", client.pathPrefix)]; calls != 1 {
+		t.Errorf("MarketingEvent.Create sent %d requests, expected 1", calls)
+	}
+}
+
+func TestMarketingEventUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://"+testHost+"/%s/marketing_events/998730532.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("marketing_event.json")))
+
+	update := validMarketingEvent()
+	update.ID = 998730532
+	event, err := client.MarketingEvent.Update(update)
+	if err != nil {
+		t.Fatalf("MarketingEvent.Update returned error: %v", err)
+	}
+
+	marketingEventTests(t, *event)
+
+	invalid := validMarketingEvent()
+	invalid.ID = 998730532
+	invalid.BudgetType = "weekly"
+	_, err = client.MarketingEvent.Update(invalid)
+	if err == nil {
+		t.Errorf("MarketingEvent.Update returned no error for an event with an invalid budget type")
+	}
+
+	info := httpmock.GetCallCountInfo()
+	if calls := info[fmt.Sprintf("PUT https://"+testHost+"/%s/marketing_events/998730532.json", client.pathPrefix)]; calls != 1 {
+		t.Errorf("MarketingEvent.Update sent %d requests, expected 1", calls)
+	}
+}
+
+func TestMarketingEventDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("https://"+testHost+"/%s/marketing_events/998730532.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.MarketingEvent.Delete(998730532)
+	if err != nil {
+		t.Errorf("MarketingEvent.Delete returned error: %v", err)
+	}
+}
+
+func TestMarketingEventCreateEngagements(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://"+testHost+"/%s/marketing_events/998730532/engagements.json", client.pathPrefix),
+		httpmock.NewStringResponder(201, `{"engagements": [{"occurred_on": "2023-01-15", "views_count": 10, "clicks_count": 3, "ad_spend": "5.25", "is_cumulative": true}]}`))
+
+	adSpend := decimal.RequireFromString("5.25")
+	engagements := []MarketingEventEngagement{
+		{OccurredOn: "2023-01-15", ViewsCount: 10, ClicksCount: 3, AdSpend: &adSpend, IsCumulative: true},
+	}
+	returnedEngagements, err := client.MarketingEvent.CreateEngagements(998730532, engagements)
+	if err != nil {
+		t.Fatalf("MarketingEvent.CreateEngagements returned error: %v", err)
+	}
+
+	if len(returnedEngagements) != 1 || returnedEngagements[0].ViewsCount != 10 || !returnedEngagements[0].AdSpend.Equal(adSpend) {
+		t.Errorf("MarketingEvent.CreateEngagements returned %+v, expected %+v", returnedEngagements, engagements)
+	}
+
+	_, err = client.MarketingEvent.CreateEngagements(998730532, []MarketingEventEngagement{{OccurredOn: "15/01/2023"}})
+	if err == nil {
+		t.Errorf("MarketingEvent.CreateEngagements returned no error for an invalid occurred on")
+	}
+}
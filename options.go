@@ -3,6 +3,10 @@ package goshopify
 import (
 	"fmt"
 	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Option is used to configure client with options
@@ -32,9 +36,41 @@ func WithLogger(logger LeveledLoggerInterface) Option {
 	}
 }
 
+// WithRedactor sets the Redactor used to mask sensitive values in logged
+// request and response bodies. Passing nil logs bodies unmodified.
+func WithRedactor(redactor *Redactor) Option {
+	return func(c *Client) {
+		c.redactor = redactor
+	}
+}
+
 // WithHTTPClient is used to set a custom http client
 func WithHTTPClient(client *http.Client) Option {
 	return func(c *Client) {
 		c.Client = client
 	}
 }
+
+// WithTelemetry enables OpenTelemetry instrumentation of every request made
+// by the client. A span is started for each call, using the context of the
+// request as parent, and request metrics are recorded. Nil providers fall back
+// to the globally registered ones.
+func WithTelemetry(tp trace.TracerProvider, mp metric.MeterProvider) Option {
+	return func(c *Client) {
+		t, err := newTelemetry(tp, mp)
+		if err != nil {
+			c.log.Errorf("telemetry disabled: %v", err)
+			return
+		}
+		c.telemetry = t
+	}
+}
+
+// WithReferenceCache caches the countries, provinces, currencies and policies
+// of the store for ttl, as they rarely change. Creating, updating or deleting
+// them with the client purges the cache.
+func WithReferenceCache(ttl time.Duration) Option {
+	return func(c *Client) {
+		c.referenceCache = newReferenceCache(ttl)
+	}
+}
@@ -18,6 +18,7 @@ const ordersResourceName = "orders"
 type OrderService interface {
 	List(interface{}) ([]Order, error)
 	ListWithPagination(interface{}) ([]Order, *Pagination, error)
+	ListEach(interface{}, func(Order) error) error
 	Count(interface{}) (int, error)
 	Get(int64, interface{}) (*Order, error)
 	Create(Order) (*Order, error)
@@ -448,6 +449,7 @@ type Transaction struct {
 	ProcessedAt                     *time.Time                       `json:"processed_at,omitempty" bson:"processed_at,omitempty"`
 	Receipt                         *Receipt                         `json:"receipt,omitempty" bson:"receipt,omitempty"`
 	CurrencyExchangeAdjustment      *CurrencyExchangeAdjustment      `json:"currency_exchange_adjustment,omitempty" bson:"currency_exchange_adjustment,omitempty"`
+	MaximumRefundable               *decimal.Decimal                 `json:"maximum_refundable,omitempty" bson:"maximum_refundable,omitempty"` // only for refund calculations
 }
 
 type ClientDetails struct {
@@ -471,16 +473,18 @@ type OrderAdjustment struct {
 	TaxAmountSet *AmountSet `json:"tax_amount_set,omitempty" bson:"tax_amount_set,omitempty"`
 }
 
+// RefundDuty selects a duty to refund, RefundType is either "FULL" or
+// "PROPORTIONAL".
 type RefundDuty struct {
 	DutyId     int64  `json:"duty_id,omitempty" bson:"duty_id,omitempty"`
 	RefundType string `json:"refund_type,omitempty" bson:"refund_type,omitempty"`
 }
 
 type Refund struct {
-	Id        int64      `json:"id,omitempty" bson:"id,omitempty"`
-	OrderId   int64      `json:"order_id,omitempty" bson:"order_id,omitempty"`
-	CreatedAt *time.Time `json:"created_at,omitempty" bson:"created_at,omitempty"`
-	// Duties           map[string]Duty   `json:"duties,omitempty" bson:"duties,omitempty"` // @todo
+	Id               int64             `json:"id,omitempty" bson:"id,omitempty"`
+	OrderId          int64             `json:"order_id,omitempty" bson:"order_id,omitempty"`
+	CreatedAt        *time.Time        `json:"created_at,omitempty" bson:"created_at,omitempty"`
+	Duties           []Duty            `json:"duties,omitempty" bson:"duties,omitempty"`
 	Note             *string           `json:"note,omitempty" bson:"note,omitempty"`
 	Restock          bool              `json:"restock,omitempty" bson:"restock,omitempty"` // @deprecated
 	UserId           *int64            `json:"user_id,omitempty" bson:"user_id,omitempty"`
@@ -488,7 +492,19 @@ type Refund struct {
 	RefundLineItems  []RefundLineItem  `json:"refund_line_items,omitempty" bson:"refund_line_items,omitempty"`
 	Transactions     []Transaction     `json:"transactions,omitempty" bson:"transactions,omitempty"`
 	OrderAdjustments []OrderAdjustment `json:"order_adjustments,omitempty" bson:"order_adjustments,omitempty"`
-	// RefundDuties     []RefundDuty      `json:"refund_duties,omitempty" bson:"refund_duties,omitempty"` // @todo
+	RefundDuties     []RefundDuty      `json:"refund_duties,omitempty" bson:"refund_duties,omitempty"`
+	Currency         string            `json:"currency,omitempty" bson:"currency,omitempty"`
+	Notify           bool              `json:"notify,omitempty" bson:"notify,omitempty"`
+	Shipping         *RefundShipping   `json:"shipping,omitempty" bson:"shipping,omitempty"`
+}
+
+// RefundShipping is the shipping refund requested when calculating or
+// creating a refund, and the refundable amounts returned by a calculation.
+type RefundShipping struct {
+	FullRefund        bool             `json:"full_refund,omitempty" bson:"full_refund,omitempty"`
+	Amount            *decimal.Decimal `json:"amount,omitempty" bson:"amount,omitempty"`
+	Tax               *decimal.Decimal `json:"tax,omitempty" bson:"tax,omitempty"`
+	MaximumRefundable *decimal.Decimal `json:"maximum_refundable,omitempty" bson:"maximum_refundable,omitempty"`
 }
 
 type RefundLineItem struct {
@@ -534,6 +550,50 @@ func (s *OrderServiceOp) ListWithPagination(options interface{}) ([]Order, *Pagi
 	return resource.Orders, pagination, nil
 }
 
+// ListEach lists orders page by page, passing each order to fn instead of
+// loading all the pages into memory. A page is decoded straight from the
+// response body into at most one page of orders, which bounds the memory
+// used, but every order is still allocated like with List. fn is only called
+// once the page has been received, so it may use the client itself. The next
+// pages are requested until there are none left or fn returns an error, which
+// is then returned.
+func (s *OrderServiceOp) ListEach(options interface{}, fn func(Order) error) error {
+	path := fmt.Sprintf("%s.json", ordersBasePath)
+	var page []Order
+	stream := &listStream{
+		key: ordersResourceName,
+		each: func(decoder *json.Decoder) error {
+			page = append(page, Order{})
+			return decoder.Decode(&page[len(page)-1])
+		},
+	}
+
+	for {
+		page = page[:0]
+		headers, err := s.client.createAndDoGetHeaders("GET", path, nil, options, stream)
+		if err != nil {
+			return err
+		}
+
+		// The client is locked while the response is decoded, fn is called
+		// after the request completed
+		for _, order := range page {
+			if err := fn(order); err != nil {
+				return err
+			}
+		}
+
+		pagination, err := extractPagination(headers.Get("Link"))
+		if err != nil {
+			return err
+		}
+		if pagination.NextPageOptions == nil {
+			return nil
+		}
+		options = pagination.NextPageOptions
+	}
+}
+
 // Count orders
 func (s *OrderServiceOp) Count(options interface{}) (int, error) {
 	path := fmt.Sprintf("%s/count.json", ordersBasePath)
@@ -0,0 +1,130 @@
+package goshopify
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+const orderRisksResourceName = "risks"
+
+// Recommendations of an order risk, from the least to the most severe
+const (
+	OrderRiskRecommendationAccept      = "accept"
+	OrderRiskRecommendationInvestigate = "investigate"
+	OrderRiskRecommendationCancel      = "cancel"
+)
+
+// orderRiskSeverity ranks the recommendations of order risks
+var orderRiskSeverity = map[string]int{
+	OrderRiskRecommendationAccept:      0,
+	OrderRiskRecommendationInvestigate: 1,
+	OrderRiskRecommendationCancel:      2,
+}
+
+// OrderRiskService is an interface for interfacing with the order risk
+// endpoints of the Shopify API.
+// See: https://shopify.dev/docs/api/admin-rest/2023-01/resources/order-risk
+type OrderRiskService interface {
+	List(int64, interface{}) ([]OrderRisk, error)
+	Get(int64, int64, interface{}) (*OrderRisk, error)
+	Create(int64, OrderRisk) (*OrderRisk, error)
+	Update(int64, OrderRisk) (*OrderRisk, error)
+	Delete(int64, int64) error
+}
+
+// OrderRiskServiceOp handles communication with the order risk related methods
+// of the Shopify API.
+type OrderRiskServiceOp struct {
+	client *Client
+}
+
+// OrderRisk represents the result of a fraud check on an order
+type OrderRisk struct {
+	ID              int64            `json:"id,omitempty" bson:"id,omitempty"`
+	OrderID         int64            `json:"order_id,omitempty" bson:"order_id,omitempty"`
+	CheckoutID      int64            `json:"checkout_id,omitempty" bson:"checkout_id,omitempty"`
+	Source          string           `json:"source,omitempty" bson:"source,omitempty"`
+	Score           *decimal.Decimal `json:"score,omitempty" bson:"score,omitempty"`
+	Recommendation  string           `json:"recommendation,omitempty" bson:"recommendation,omitempty"`
+	Display         *bool            `json:"display,omitempty" bson:"display,omitempty"`
+	CauseCancel     *bool            `json:"cause_cancel,omitempty" bson:"cause_cancel,omitempty"`
+	Message         string           `json:"message,omitempty" bson:"message,omitempty"`
+	MerchantMessage string           `json:"merchant_message,omitempty" bson:"merchant_message,omitempty"`
+}
+
+// OrderRiskResource represents the result from the orders/X/risks/Y.json endpoint
+type OrderRiskResource struct {
+	Risk *OrderRisk `json:"risk" bson:"risk"`
+}
+
+// OrderRisksResource represents the result from the orders/X/risks.json endpoint
+type OrderRisksResource struct {
+	Risks []OrderRisk `json:"risks" bson:"risks"`
+}
+
+// List risks of an order
+func (s *OrderRiskServiceOp) List(orderID int64, options interface{}) ([]OrderRisk, error) {
+	path := fmt.Sprintf("%s/%d/%s.json", ordersBasePath, orderID, orderRisksResourceName)
+	resource := new(OrderRisksResource)
+	err := s.client.Get(path, resource, options)
+	return resource.Risks, err
+}
+
+// Get individual risk of an order
+func (s *OrderRiskServiceOp) Get(orderID int64, riskID int64, options interface{}) (*OrderRisk, error) {
+	path := fmt.Sprintf("%s/%d/%s/%d.json", ordersBasePath, orderID, orderRisksResourceName, riskID)
+	resource := new(OrderRiskResource)
+	err := s.client.Get(path, resource, options)
+	return resource.Risk, err
+}
+
+// Create a new risk for an order
+func (s *OrderRiskServiceOp) Create(orderID int64, risk OrderRisk) (*OrderRisk, error) {
+	path := fmt.Sprintf("%s/%d/%s.json", ordersBasePath, orderID, orderRisksResourceName)
+	wrappedData := OrderRiskResource{Risk: &risk}
+	resource := new(OrderRiskResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.Risk, err
+}
+
+// Update an existing risk of an order. Risks created by other apps can't be
+// updated.
+func (s *OrderRiskServiceOp) Update(orderID int64, risk OrderRisk) (*OrderRisk, error) {
+	path := fmt.Sprintf("%s/%d/%s/%d.json", ordersBasePath, orderID, orderRisksResourceName, risk.ID)
+	wrappedData := OrderRiskResource{Risk: &risk}
+	resource := new(OrderRiskResource)
+	err := s.client.Put(path, wrappedData, resource)
+	return resource.Risk, err
+}
+
+// Delete an existing risk of an order. Risks created by other apps can't be
+// deleted.
+func (s *OrderRiskServiceOp) Delete(orderID int64, riskID int64) error {
+	return s.client.Delete(fmt.Sprintf("%s/%d/%s/%d.json", ordersBasePath, orderID, orderRisksResourceName, riskID))
+}
+
+// RiskRecommendation aggregates the risks of the order into an overall
+// recommendation, the most severe one of its risks. A risk causing the
+// cancellation of the order recommends to cancel it, and an order without
+// risks is accepted. Risks of other orders are ignored, as are unknown
+// recommendations.
+func (o Order) RiskRecommendation(risks []OrderRisk) string {
+	recommendation := OrderRiskRecommendationAccept
+	for _, risk := range risks {
+		if risk.OrderID != 0 && risk.OrderID != o.ID {
+			continue
+		}
+
+		current := risk.Recommendation
+		if risk.CauseCancel != nil && *risk.CauseCancel {
+			current = OrderRiskRecommendationCancel
+		}
+
+		severity, ok := orderRiskSeverity[current]
+		if ok && severity > orderRiskSeverity[recommendation] {
+			recommendation = current
+		}
+	}
+	return recommendation
+}
@@ -0,0 +1,185 @@
+package goshopify
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func orderRiskTests(t *testing.T, risk OrderRisk) {
+	// Check that ID is assigned to the returned risk
+	expectedID := int64(284138680)
+	if risk.ID != expectedID {
+		t.Errorf("OrderRisk.ID returned %+v, expected %+v", risk.ID, expectedID)
+	}
+
+	// Check that the OrderID value is assigned to the returned risk
+	expectedOrderID := int64(450789469)
+	if risk.OrderID != expectedOrderID {
+		t.Errorf("OrderRisk.OrderID returned %+v, expected %+v", risk.OrderID, expectedOrderID)
+	}
+
+	expectedScore := decimal.RequireFromString("1.0")
+	if risk.Score == nil || !risk.Score.Equal(expectedScore) {
+		t.Errorf("OrderRisk.Score returned %v, expected %v", risk.Score, expectedScore)
+	}
+
+	if risk.Recommendation != OrderRiskRecommendationCancel || risk.CauseCancel == nil || !*risk.CauseCancel || risk.Display == nil || !*risk.Display {
+		t.Errorf("OrderRisk returned %+v, expected a displayed risk causing a cancel", risk)
+	}
+}
+
+func TestOrderRiskList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/orders/450789469/risks.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("order_risks.json")))
+
+	risks, err := client.OrderRisk.List(450789469, nil)
+	if err != nil {
+		t.Errorf("OrderRisk.List returned error: %v", err)
+	}
+
+	if len(risks) != 2 {
+		t.Fatalf("OrderRisk.List got %d risks, expected 2", len(risks))
+	}
+	orderRiskTests(t, risks[0])
+}
+
+func TestOrderRiskGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/orders/450789469/risks/284138680.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("order_risk.json")))
+
+	risk, err := client.OrderRisk.Get(450789469, 284138680, nil)
+	if err != nil {
+		t.Errorf("OrderRisk.Get returned error: %v", err)
+	}
+
+	orderRiskTests(t, *risk)
+}
+
+func TestOrderRiskCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://"+testHost+"/%s/orders/450789469/risks.json", client.pathPrefix),
+		httpmock.NewBytesResponder(201, loadFixture("order_risk.json")))
+
+	score := decimal.RequireFromString("1.0")
+	causeCancel, display := true, true
+	risk := OrderRisk{
+		Message:        "This order was placed from a proxy IP",
+		Recommendation: OrderRiskRecommendationCancel,
+		Score:          &score,
+		Source:         "External",
+		CauseCancel:    &causeCancel,
+		Display:        &display,
+	}
+
+	returnedRisk, err := client.OrderRisk.Create(450789469, risk)
+	if err != nil {
+		t.Errorf("OrderRisk.Create returned error: %v", err)
+	}
+
+	orderRiskTests(t, *returnedRisk)
+}
+
+func TestOrderRiskUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body []byte
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://"+testHost+"/%s/orders/450789469/risks/284138680.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			body, _ = io.ReadAll(req.Body)
+			return httpmock.NewBytesResponse(200, loadFixture("order_risk.json")), nil
+		})
+
+	risk := OrderRisk{
+		ID:             284138680,
+		Recommendation: OrderRiskRecommendationCancel,
+	}
+
+	returnedRisk, err := client.OrderRisk.Update(450789469, risk)
+	if err != nil {
+		t.Errorf("OrderRisk.Update returned error: %v", err)
+	}
+
+	orderRiskTests(t, *returnedRisk)
+
+	// Flags left unset are not sent, so they are not reset
+	if bytes.Contains(body, []byte("display")) || bytes.Contains(body, []byte("cause_cancel")) {
+		t.Errorf("OrderRisk.Update sent %s, expected no display or cause_cancel", body)
+	}
+}
+
+func TestOrderRiskDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("https://"+testHost+"/%s/orders/450789469/risks/284138680.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.OrderRisk.Delete(450789469, 284138680)
+	if err != nil {
+		t.Errorf("OrderRisk.Delete returned error: %v", err)
+	}
+}
+
+func TestOrderRiskRecommendation(t *testing.T) {
+	order := Order{ID: 450789469}
+	causeCancel := true
+
+	cases := []struct {
+		description string
+		risks       []OrderRisk
+		expected    string
+	}{
+		{"no risks", nil, OrderRiskRecommendationAccept},
+		{
+			"accepted risks",
+			[]OrderRisk{{OrderID: 450789469, Recommendation: "accept"}},
+			OrderRiskRecommendationAccept,
+		},
+		{
+			"most severe risk",
+			[]OrderRisk{
+				{OrderID: 450789469, Recommendation: "accept"},
+				{OrderID: 450789469, Recommendation: "investigate"},
+				{OrderID: 450789469, Recommendation: "accept"},
+			},
+			OrderRiskRecommendationInvestigate,
+		},
+		{
+			"risk causing a cancel",
+			[]OrderRisk{{OrderID: 450789469, Recommendation: "accept", CauseCancel: &causeCancel}},
+			OrderRiskRecommendationCancel,
+		},
+		{
+			"risk of another order",
+			[]OrderRisk{{OrderID: 1, Recommendation: "cancel"}},
+			OrderRiskRecommendationAccept,
+		},
+		{
+			"unknown recommendation",
+			[]OrderRisk{{Recommendation: "unknown"}, {Recommendation: "investigate"}},
+			OrderRiskRecommendationInvestigate,
+		},
+	}
+
+	for _, c := range cases {
+		actual := order.RiskRecommendation(c.risks)
+		if actual != c.expected {
+			t.Errorf("Order.RiskRecommendation with %s returned %s, expected %s", c.description, actual, c.expected)
+		}
+	}
+}
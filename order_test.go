@@ -266,6 +266,163 @@ func TestOrderListOptions(t *testing.T) {
 	orderTests(t, order)
 }
 
+func TestOrderListEach(t *testing.T) {
+	setup()
+	defer teardown()
+
+	listURL := fmt.Sprintf("https://"+testHost+"/%s/orders.json", client.pathPrefix)
+
+	httpmock.RegisterResponder("GET", listURL,
+		func(req *http.Request) (*http.Response, error) {
+			if req.URL.Query().Get("page_info") == "pg2" {
+				return httpmock.NewStringResponse(200, `{"orders": [{"id":3}]}`), nil
+			}
+			resp := httpmock.NewStringResponse(200, `{"count": 2, "orders": [{"id":1},{"id":2}], "extra": {"orders": []}}`)
+			resp.Header.Add("Link", `<http://valid.url?page_info=pg2&limit=2>; rel="next"`)
+			return resp, nil
+		})
+
+	var ids []int64
+	err := client.Order.ListEach(nil, func(order Order) error {
+		ids = append(ids, order.ID)
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Order.ListEach returned error: %v", err)
+	}
+
+	expected := []int64{1, 2, 3}
+	if !reflect.DeepEqual(ids, expected) {
+		t.Errorf("Order.ListEach returned %v, expected %v", ids, expected)
+	}
+
+	// an error from the callback stops the iteration
+	stop := errors.New("stop")
+	ids = nil
+	err = client.Order.ListEach(nil, func(order Order) error {
+		ids = append(ids, order.ID)
+		return stop
+	})
+	if err != stop {
+		t.Errorf("Order.ListEach returned error %v, expected %v", err, stop)
+	}
+	if !reflect.DeepEqual(ids, []int64{1}) {
+		t.Errorf("Order.ListEach returned %v, expected %v", ids, []int64{1})
+	}
+}
+
+func TestOrderListEachNestedCall(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/orders.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"orders": [{"id":1},{"id":2}]}`))
+	httpmock.RegisterResponder("GET", `=~^https://`+testHost+`/`+client.pathPrefix+`/orders/\d+/transactions.json$`,
+		httpmock.NewStringResponder(200, `{"transactions": [{"id":10}]}`))
+
+	done := make(chan error, 1)
+	var transactions int
+	go func() {
+		done <- client.Order.ListEach(nil, func(order Order) error {
+			list, err := client.Transaction.List(order.ID, nil)
+			transactions += len(list)
+			return err
+		})
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Order.ListEach returned error: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Order.ListEach deadlocked calling the client from the callback")
+	}
+
+	if transactions != 2 {
+		t.Errorf("Order.ListEach callback listed %d transactions, expected 2", transactions)
+	}
+}
+
+func TestOrderListEachError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	listURL := fmt.Sprintf("https://"+testHost+"/%s/orders.json", client.pathPrefix)
+
+	cases := []struct {
+		responder   httpmock.Responder
+		expectedErr string
+	}{
+		{httpmock.NewStringResponder(500, ""), "Unknown Error"},
+		{httpmock.NewStringResponder(200, `{"orders": {"id":1}}`), `expected array for "orders", got {`},
+		{httpmock.NewStringResponder(200, `[]`), "expected { in response, got ["},
+		{httpmock.NewStringResponder(200, `{"orders": [{"id":"1"}]}`), "json: cannot unmarshal string into Go struct field Order.id of type int64"},
+	}
+
+	for _, c := range cases {
+		httpmock.RegisterResponder("GET", listURL, c.responder)
+
+		err := client.Order.ListEach(nil, func(order Order) error { return nil })
+		if err == nil || err.Error() != c.expectedErr {
+			t.Errorf("Order.ListEach err returned %+v, expected %+v", err, c.expectedErr)
+		}
+	}
+}
+
+// orderPage returns an orders.json response body holding n copies of the
+// order fixture.
+func orderPage(n int) string {
+	order := strings.TrimSpace(string(loadFixture("order.json")))
+	order = strings.TrimSuffix(strings.TrimPrefix(order, `{"order":`), "}")
+	orders := make([]string, n)
+	for i := range orders {
+		orders[i] = order
+	}
+	return `{"orders":[` + strings.Join(orders, ",") + `]}`
+}
+
+func BenchmarkOrderList(b *testing.B) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/orders.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, orderPage(250)))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		orders, err := client.Order.List(nil)
+		if err != nil || len(orders) != 250 {
+			b.Fatalf("Order.List returned %d orders, error: %v", len(orders), err)
+		}
+	}
+}
+
+// BenchmarkOrderListEach shows fewer bytes allocated per page than
+// BenchmarkOrderList, as the response body is not buffered, while the number
+// of allocations, mostly those of the decoded orders, is the same.
+func BenchmarkOrderListEach(b *testing.B) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/orders.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, orderPage(250)))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n := 0
+		err := client.Order.ListEach(nil, func(order Order) error {
+			n++
+			return nil
+		})
+		if err != nil || n != 250 {
+			b.Fatalf("Order.ListEach returned %d orders, error: %v", n, err)
+		}
+	}
+}
+
 func TestOrderGet(t *testing.T) {
 	setup()
 	defer teardown()
@@ -0,0 +1,38 @@
+package goshopify
+
+import "time"
+
+// PolicyService is an interface for interfacing with the policy endpoint of
+// the Shopify API.
+// See: https://shopify.dev/docs/api/admin-rest/2023-01/resources/policy
+type PolicyService interface {
+	List() ([]Policy, error)
+}
+
+// PolicyServiceOp handles communication with the policy related methods of
+// the Shopify API.
+type PolicyServiceOp struct {
+	client *Client
+}
+
+// Policy represents a legal policy of the store, e.g. its refund policy
+type Policy struct {
+	Title     string     `json:"title,omitempty" bson:"title,omitempty"`
+	Body      string     `json:"body,omitempty" bson:"body,omitempty"`
+	Handle    string     `json:"handle,omitempty" bson:"handle,omitempty"`
+	URL       string     `json:"url,omitempty" bson:"url,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty" bson:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty" bson:"updated_at,omitempty"`
+}
+
+// PoliciesResource represents the result from the policies.json endpoint
+type PoliciesResource struct {
+	Policies []Policy `json:"policies" bson:"policies"`
+}
+
+// List policies
+func (s *PolicyServiceOp) List() ([]Policy, error) {
+	resource := new(PoliciesResource)
+	err := s.client.getReference("policies.json", resource, nil)
+	return resource.Policies, err
+}
@@ -0,0 +1,33 @@
+package goshopify
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func TestPolicyList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/policies.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("policies.json")))
+
+	policies, err := client.Policy.List()
+	if err != nil {
+		t.Errorf("Policy.List returned error: %v", err)
+	}
+
+	if len(policies) != 1 {
+		t.Fatalf("Policy.List got %d policies, expected 1", len(policies))
+	}
+
+	policy := policies[0]
+	if policy.Handle != "refund-policy" || policy.Title != "Refund policy" {
+		t.Errorf("Policy.List returned %+v, expected the refund policy", policy)
+	}
+	if policy.CreatedAt == nil {
+		t.Errorf("Policy.CreatedAt returned nil, expected a time")
+	}
+}
@@ -39,6 +39,7 @@ type ProductService interface {
 	Get(int64, interface{}) (*Product, error)
 	Create(Product) (*Product, error)
 	Update(Product) (*Product, error)
+	Patch(ProductPatch) (*Product, error)
 	Delete(int64) error
 
 	// MetafieldsService used for Product resource to communicate with Metafields resource
@@ -253,6 +254,15 @@ func (s *ProductServiceOp) Update(product Product) (*Product, error) {
 	return resource.Product, err
 }
 
+// Patch an existing product, only sending the changed fields. See DiffProduct.
+func (s *ProductServiceOp) Patch(patch ProductPatch) (*Product, error) {
+	path := fmt.Sprintf("%s/%d.json", productsBasePath, patch.ID)
+	wrappedData := map[string]ProductPatch{"product": patch}
+	resource := new(ProductResource)
+	err := s.client.Put(path, wrappedData, resource)
+	return resource.Product, err
+}
+
 // Delete an existing product
 func (s *ProductServiceOp) Delete(productID int64) error {
 	return s.client.Delete(fmt.Sprintf("%s/%d.json", productsBasePath, productID))
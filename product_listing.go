@@ -51,7 +51,11 @@ type ProductListing struct {
 	Options     []ProductOption `json:"options,omitempty" bson:"options,omitempty"`
 	Variants    []Variant       `json:"variants,omitempty" bson:"variants,omitempty"`
 	Images      []Image         `json:"images,omitempty" bson:"images,omitempty"`
-	ProductId   uint64          `json:"product_id,omitempty" bson:"product_id,omitempty"`
+
+	// ProductId is not decoded, the product_id of listings is decoded into ID.
+	//
+	// Deprecated: use ID.
+	ProductId uint64 `json:"-" bson:"product_id,omitempty"`
 }
 
 // Represents the result from the product_listings/X.json endpoint
@@ -0,0 +1,229 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// productPatchSkippedFields are the fields which are read-only or updated
+// through other endpoints, like metafields, and are never part of a patch
+var productPatchSkippedFields = map[string]bool{
+	"id":                     true,
+	"product_id":             true,
+	"created_at":             true,
+	"updated_at":             true,
+	"admin_graphql_api_id":   true,
+	"image":                  true,
+	"metafields":             true,
+	"presentment_prices":     true,
+	"old_inventory_quantity": true,
+}
+
+// ProductPatch is a partial update of a product, holding only the fields
+// which changed. Cleared fields are sent explicitly: pointers and times as
+// null, lists as empty lists and other values as their zero value, which the
+// omitempty tags of Product cannot do. Build it with DiffProduct and send it
+// with ProductService.Patch.
+type ProductPatch struct {
+	ID     int64
+	fields map[string]interface{}
+}
+
+// DiffProduct returns the patch turning the original product into the
+// modified one. Variants, options and images are matched by ID. When any of
+// them changes the whole list is sent, since Shopify deletes the ones left
+// out, but the unchanged ones only with their ID, and the changed ones with
+// their ID and changed fields.
+func DiffProduct(original, modified Product) ProductPatch {
+	id := original.ID
+	if id == 0 {
+		id = modified.ID
+	}
+	return ProductPatch{
+		ID:     id,
+		fields: diffFields(reflect.ValueOf(original), reflect.ValueOf(modified)),
+	}
+}
+
+// Clone returns a deep copy of the product, whose variants, options, images,
+// metafields and pointed values can be changed without changing the product,
+// e.g. to build the modified product given to DiffProduct.
+func (p Product) Clone() Product {
+	return deepCopy(reflect.ValueOf(p)).Interface().(Product)
+}
+
+// IsEmpty reports whether the patch changes nothing
+func (p ProductPatch) IsEmpty() bool {
+	return len(p.fields) == 0
+}
+
+// MarshalJSON encodes the ID and the changed fields of the product
+func (p ProductPatch) MarshalJSON() ([]byte, error) {
+	fields := make(map[string]interface{}, len(p.fields)+1)
+	for name, value := range p.fields {
+		fields[name] = value
+	}
+	fields["id"] = p.ID
+	return json.Marshal(fields)
+}
+
+// diffFields returns the changed fields of two structs of the same type by
+// their JSON name
+func diffFields(original, modified reflect.Value) map[string]interface{} {
+	fields := map[string]interface{}{}
+	for i := 0; i < modified.NumField(); i++ {
+		name := jsonFieldName(modified.Type().Field(i))
+		if name == "" || productPatchSkippedFields[name] {
+			continue
+		}
+
+		before, after := original.Field(i), modified.Field(i)
+		if isIdentifiedList(after.Type()) {
+			if list, changed := diffList(before, after); changed {
+				fields[name] = list
+			}
+			continue
+		}
+
+		if !equalValues(before, after) {
+			fields[name] = patchValue(after)
+		}
+	}
+	return fields
+}
+
+// diffList returns the elements of a list of structs with an ID, like the
+// variants of a product, and whether the list changed
+func diffList(original, modified reflect.Value) ([]interface{}, bool) {
+	originals := map[int64]reflect.Value{}
+	for i := 0; i < original.Len(); i++ {
+		element := original.Index(i)
+		originals[element.FieldByName("ID").Int()] = element
+	}
+
+	changed := original.Len() != modified.Len()
+	list := make([]interface{}, 0, modified.Len())
+	for i := 0; i < modified.Len(); i++ {
+		element := modified.Index(i)
+		id := element.FieldByName("ID").Int()
+
+		previous, ok := originals[id]
+		if id == 0 || !ok {
+			// New elements are sent with all their fields
+			previous = reflect.Zero(element.Type())
+			changed = true
+		} else if i >= original.Len() || original.Index(i).FieldByName("ID").Int() != id {
+			// Moved elements change the order of the list
+			changed = true
+		}
+
+		fields := diffFields(previous, element)
+		if len(fields) > 0 {
+			changed = true
+		}
+		if id != 0 {
+			fields["id"] = id
+		}
+		list = append(list, fields)
+	}
+	return list, changed
+}
+
+// isIdentifiedList reports whether a type is a list of structs with an ID
+func isIdentifiedList(t reflect.Type) bool {
+	if t.Kind() != reflect.Slice || t.Elem().Kind() != reflect.Struct {
+		return false
+	}
+	field, ok := t.Elem().FieldByName("ID")
+	return ok && field.Type.Kind() == reflect.Int64
+}
+
+// equalValues compares two values, decimals and times by what they represent
+func equalValues(a, b reflect.Value) bool {
+	if a.Kind() == reflect.Ptr && !a.IsNil() && !b.IsNil() {
+		return equalValues(a.Elem(), b.Elem())
+	}
+
+	switch a := a.Interface().(type) {
+	case decimal.Decimal:
+		return a.Equal(b.Interface().(decimal.Decimal))
+	case time.Time:
+		return a.Equal(b.Interface().(time.Time))
+	}
+	return reflect.DeepEqual(a.Interface(), b.Interface())
+}
+
+// patchValue returns the value to send for a changed field
+func patchValue(v reflect.Value) interface{} {
+	switch {
+	case v.Kind() == reflect.Slice && v.Len() == 0:
+		return []interface{}{}
+	case v.Kind() == reflect.Ptr && v.IsNil():
+		return nil
+	}
+	return v.Interface()
+}
+
+// deepCopy copies a value along with the pointers, slices, maps and
+// interfaces it holds. Unexported fields, like those of decimals and times,
+// are shared, as these types are not changed in place.
+func deepCopy(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type().Elem())
+		c.Elem().Set(deepCopy(v.Elem()))
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(deepCopy(v.Index(i)))
+		}
+		return c
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		for iter := v.MapRange(); iter.Next(); {
+			c.SetMapIndex(iter.Key(), deepCopy(iter.Value()))
+		}
+		return c
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type()).Elem()
+		c.Set(deepCopy(v.Elem()))
+		return c
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if c.Field(i).CanSet() {
+				c.Field(i).Set(deepCopy(v.Field(i)))
+			}
+		}
+		return c
+	}
+	return v
+}
+
+// jsonFieldName returns the JSON name of a struct field, or "" when it is
+// not encoded
+func jsonFieldName(field reflect.StructField) string {
+	name := strings.Split(field.Tag.Get("json"), ",")[0]
+	if name == "-" || field.PkgPath != "" {
+		return ""
+	}
+	return name
+}
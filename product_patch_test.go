@@ -0,0 +1,191 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func patchTestProduct() Product {
+	publishedAt := time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC)
+	price := decimal.RequireFromString("10.00")
+	return Product{
+		ID:          1,
+		Title:       "Shirt",
+		Vendor:      "Acme",
+		Tags:        "summer, sale",
+		PublishedAt: &publishedAt,
+		Options:     []ProductOption{{ID: 21, Name: "Size", Values: []string{"S", "M"}}},
+		Variants: []Variant{
+			{ID: 11, Sku: "S", Option1: "S", Price: &price, Taxable: true},
+			{ID: 12, Sku: "M", Option1: "M", Price: &price, Taxable: true},
+		},
+		Images: []Image{{ID: 31, Src: "https://example.com/shirt.png"}},
+	}
+}
+
+func patchJSON(t *testing.T, patch ProductPatch) string {
+	body, err := json.Marshal(patch)
+	if err != nil {
+		t.Fatalf("ProductPatch.MarshalJSON returned error: %v", err)
+	}
+	return string(body)
+}
+
+func TestDiffProduct(t *testing.T) {
+	original := patchTestProduct()
+	later := original.PublishedAt.Add(time.Hour)
+
+	cases := []struct {
+		description string
+		modify      func(*Product)
+		expected    string
+	}{
+		{
+			"unchanged",
+			func(p *Product) {
+				// Equal decimals and times in other representations are unchanged
+				price := decimal.RequireFromString("10.0")
+				p.Variants[0].Price = &price
+				publishedAt := p.PublishedAt.In(time.FixedZone("CET", 3600))
+				p.PublishedAt = &publishedAt
+				updatedAt := later
+				p.UpdatedAt = &updatedAt
+			},
+			`{"id":1}`,
+		},
+		{
+			"changed fields",
+			func(p *Product) {
+				p.Title = "T-Shirt"
+				p.PublishedAt = &later
+			},
+			`{"id":1,"published_at":"2024-03-01T11:00:00Z","title":"T-Shirt"}`,
+		},
+		{
+			"cleared fields",
+			func(p *Product) {
+				p.Tags = ""
+				p.PublishedAt = nil
+			},
+			`{"id":1,"published_at":null,"tags":""}`,
+		},
+		{
+			"changed variant",
+			func(p *Product) {
+				price := decimal.RequireFromString("12.50")
+				p.Variants[1].Price = &price
+				p.Variants[1].Taxable = false
+			},
+			`{"id":1,"variants":[{"id":11},{"id":12,"price":"12.5","taxable":false}]}`,
+		},
+		{
+			"added and removed variants",
+			func(p *Product) {
+				p.Variants = []Variant{p.Variants[0], {Sku: "L", Option1: "L"}}
+			},
+			`{"id":1,"variants":[{"id":11},{"option1":"L","sku":"L"}]}`,
+		},
+		{
+			"reordered variants",
+			func(p *Product) {
+				p.Variants[0], p.Variants[1] = p.Variants[1], p.Variants[0]
+			},
+			`{"id":1,"variants":[{"id":12},{"id":11}]}`,
+		},
+		{
+			"changed option and removed images",
+			func(p *Product) {
+				p.Options[0].Values = []string{"S", "M", "L"}
+				p.Images = nil
+			},
+			`{"id":1,"images":[],"options":[{"id":21,"values":["S","M","L"]}]}`,
+		},
+	}
+
+	for _, c := range cases {
+		modified := original.Clone()
+		c.modify(&modified)
+
+		patch := DiffProduct(original, modified)
+		if body := patchJSON(t, patch); body != c.expected {
+			t.Errorf("DiffProduct %s returned %s, expected %s", c.description, body, c.expected)
+		}
+
+		if patch.IsEmpty() != (c.expected == `{"id":1}`) {
+			t.Errorf("ProductPatch.IsEmpty %s returned %v", c.description, patch.IsEmpty())
+		}
+	}
+}
+
+func TestProductClone(t *testing.T) {
+	product := func() Product {
+		p := patchTestProduct()
+		p.Variants[0].Metafields = []Metafield{{ID: 41, Value: map[string]interface{}{"color": "red"}}}
+		p.Images[0].VariantIds = []int64{11}
+		return p
+	}
+	original := product()
+
+	clone := original.Clone()
+	if !reflect.DeepEqual(clone, original) {
+		t.Fatalf("Product.Clone returned %+v, expected %+v", clone, original)
+	}
+
+	price := decimal.RequireFromString("12.00")
+	clone.Variants[0].Sku = "XS"
+	clone.Variants[0].Price = &price
+	clone.Variants[0].Metafields[0].Value.(map[string]interface{})["color"] = "blue"
+	clone.Options[0].Values[0] = "XS"
+	clone.Images[0].VariantIds[0] = 12
+	*clone.PublishedAt = clone.PublishedAt.Add(time.Hour)
+
+	if !reflect.DeepEqual(original, product()) {
+		t.Errorf("changing the clone changed the product to %+v", original)
+	}
+
+	patch := DiffProduct(original, clone)
+	if patch.IsEmpty() {
+		t.Errorf("DiffProduct of the changed clone returned an empty patch")
+	}
+}
+
+func TestProductPatch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body []byte
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://"+testHost+"/%s/products/1.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			var err error
+			if body, err = io.ReadAll(req.Body); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(200, `{"product":{"id":1,"title":"Shirt","tags":""}}`), nil
+		})
+
+	original := patchTestProduct()
+	modified := original.Clone()
+	modified.Tags = ""
+
+	product, err := client.Product.Patch(DiffProduct(original, modified))
+	if err != nil {
+		t.Errorf("Product.Patch returned error: %v", err)
+	}
+
+	expectedBody := `{"product":{"id":1,"tags":""}}`
+	if string(body) != expectedBody {
+		t.Errorf("Product.Patch sent %s, expected %s", body, expectedBody)
+	}
+
+	if product == nil || product.ID != 1 {
+		t.Errorf("Product.Patch returned %+v, expected product 1", product)
+	}
+}
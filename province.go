@@ -0,0 +1,67 @@
+package goshopify
+
+import "fmt"
+
+const provincesResourceName = "provinces"
+
+// ProvinceService is an interface for interfacing with the province endpoints
+// of the Shopify API. Provinces belong to a country.
+// See: https://shopify.dev/docs/api/admin-rest/2023-01/resources/province
+type ProvinceService interface {
+	List(int64, interface{}) ([]Province, error)
+	Count(int64, interface{}) (int, error)
+	Get(int64, int64, interface{}) (*Province, error)
+	Update(int64, Province) (*Province, error)
+}
+
+// ProvinceServiceOp handles communication with the province related methods
+// of the Shopify API.
+type ProvinceServiceOp struct {
+	client *Client
+}
+
+// Province represents a province of a country the store ships to, with its
+// tax rates. It shares its fields with the provinces of shipping zones.
+type Province = ShippingProvince
+
+// ProvinceResource represents the result from the countries/X/provinces/Y.json endpoint
+type ProvinceResource struct {
+	Province *Province `json:"province" bson:"province"`
+}
+
+// ProvincesResource represents the result from the countries/X/provinces.json endpoint
+type ProvincesResource struct {
+	Provinces []Province `json:"provinces" bson:"provinces"`
+}
+
+// List provinces of a country
+func (s *ProvinceServiceOp) List(countryID int64, options interface{}) ([]Province, error) {
+	path := fmt.Sprintf("%s/%d/%s.json", countriesBasePath, countryID, provincesResourceName)
+	resource := new(ProvincesResource)
+	err := s.client.getReference(path, resource, options)
+	return resource.Provinces, err
+}
+
+// Count provinces of a country
+func (s *ProvinceServiceOp) Count(countryID int64, options interface{}) (int, error) {
+	path := fmt.Sprintf("%s/%d/%s/count.json", countriesBasePath, countryID, provincesResourceName)
+	return s.client.Count(path, options)
+}
+
+// Get individual province of a country
+func (s *ProvinceServiceOp) Get(countryID int64, provinceID int64, options interface{}) (*Province, error) {
+	path := fmt.Sprintf("%s/%d/%s/%d.json", countriesBasePath, countryID, provincesResourceName, provinceID)
+	resource := new(ProvinceResource)
+	err := s.client.getReference(path, resource, options)
+	return resource.Province, err
+}
+
+// Update the tax rate of an existing province of a country
+func (s *ProvinceServiceOp) Update(countryID int64, province Province) (*Province, error) {
+	path := fmt.Sprintf("%s/%d/%s/%d.json", countriesBasePath, countryID, provincesResourceName, province.ID)
+	wrappedData := ProvinceResource{Province: &province}
+	resource := new(ProvinceResource)
+	err := s.client.Put(path, wrappedData, resource)
+	s.client.PurgeReferenceCache()
+	return resource.Province, err
+}
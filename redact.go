@@ -0,0 +1,226 @@
+package goshopify
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"strings"
+)
+
+const defaultRedactionMask = "[REDACTED]"
+
+// partialRedactionVisible is the number of trailing characters left visible
+// by partial redaction, matching the last_characters of gift cards.
+const partialRedactionVisible = 4
+
+var (
+	// email addresses in free text and URL encoded in query strings
+	emailRegex = regexp.MustCompile(`[A-Za-z0-9._%+\-]+(?:@|%40)[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+	// phone numbers in international format, e.g. +1 (613) 555-0100, and
+	// North American numbers with separators, e.g. (613) 555-0100 or
+	// 613.555.0100. Bare digits are left alone, they are mostly IDs.
+	phoneRegex = regexp.MustCompile(`\+[0-9][0-9 ().\-]{6,}[0-9]|(?:\([0-9]{3}\) ?|\b[0-9]{3}[ .\-])[0-9]{3}[ .\-][0-9]{4}\b`)
+)
+
+// defaultRedactedKeys are the JSON keys masked by NewRedactor, covering
+// credentials, names, contact details, addresses and payment details.
+var defaultRedactedKeys = []string{
+	// credentials
+	"access_token",
+	"token",
+	"api_key",
+	"api_secret",
+	"client_secret",
+	"password",
+	"password_confirmation",
+	"x-shopify-access-token",
+	"account_activation_url",
+
+	// names, only qualified by their address for name as products,
+	// variants, shipping lines and locations have one too
+	"first_name",
+	"last_name",
+	"billing_address.name",
+	"shipping_address.name",
+	"default_address.name",
+	"customer_address.name",
+	"addresses.name",
+
+	// contact details
+	"email",
+	"contact_email",
+	"phone",
+
+	// addresses
+	"address1",
+	"address2",
+	"billing_address.city",
+	"shipping_address.city",
+	"default_address.city",
+	"customer_address.city",
+	"addresses.city",
+	"zip",
+	"latitude",
+	"longitude",
+
+	// payment details
+	"payment_details",
+	"credit_card_number",
+	"credit_card_bin",
+	"authorization",
+	"receipt",
+}
+
+// mandatoryRedactedKeys are the JSON keys masked by every Redactor, including
+// a nil one, as their values are credentials: an account activation URL lets
+// anyone set the password of the customer.
+var mandatoryRedactedKeys = []string{
+	"account_activation_url",
+}
+
+// mandatoryPartiallyRedactedKeys are the qualified JSON keys partially
+// masked by every Redactor, including a nil one, so that full gift card codes
+// are never logged.
+var mandatoryPartiallyRedactedKeys = []string{
+	"gift_card.code",
+	"gift_cards.code",
+}
+
+// Redactor masks sensitive values in request and response bodies before
+// they are logged. JSON bodies are masked by key, string values and bodies
+// that are not JSON are masked by pattern.
+type Redactor struct {
+	// Keys are the JSON object keys whose values are masked, compared case
+	// insensitively. A key can be qualified by the key of its parent, e.g.
+	// "billing_address.name", to only mask it there. Objects and arrays under
+	// a matching key are masked as a whole. Account activation URLs are always
+	// masked, whether listed or not.
+	Keys []string
+
+	// PartialKeys are JSON object keys qualified by the key of their parent,
+	// e.g. "gift_card.code", whose string values are masked except for their
+	// last four characters. Values of four characters or less are fully
+	// masked. Gift card codes are always partially masked, whether listed or
+	// not.
+	PartialKeys []string
+
+	// Patterns are replaced by the mask wherever they match a string value.
+	Patterns []*regexp.Regexp
+
+	// Mask replaces redacted values, defaults to "[REDACTED]".
+	Mask string
+}
+
+// NewRedactor returns a Redactor masking tokens, names, emails, phone
+// numbers, addresses and payment details, and gift card codes but for their last
+// characters. Additional keys are masked as well.
+func NewRedactor(keys ...string) *Redactor {
+	return &Redactor{
+		Keys:        append(append([]string{}, defaultRedactedKeys...), keys...),
+		PartialKeys: append([]string{}, mandatoryPartiallyRedactedKeys...),
+		Patterns:    []*regexp.Regexp{emailRegex, phoneRegex},
+		Mask:        defaultRedactionMask,
+	}
+}
+
+// Redact returns a copy of body with sensitive values masked. A nil Redactor
+// only masks account activation URLs and gift card codes, and returns other
+// bodies untouched.
+func (r *Redactor) Redact(body []byte) []byte {
+	if len(body) == 0 {
+		return body
+	}
+	if r == nil {
+		if !bytes.Contains(body, []byte(`"code"`)) && !bytes.Contains(body, []byte(`"account_activation_url"`)) {
+			return body
+		}
+		r = &Redactor{}
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil || decoder.More() {
+		return []byte(r.redactString(string(body)))
+	}
+
+	keys := make(map[string]bool, len(r.Keys)+len(mandatoryRedactedKeys))
+	for _, k := range r.Keys {
+		keys[strings.ToLower(k)] = true
+	}
+	for _, k := range mandatoryRedactedKeys {
+		keys[k] = true
+	}
+	partialKeys := make(map[string]bool, len(r.PartialKeys)+len(mandatoryPartiallyRedactedKeys))
+	for _, k := range r.PartialKeys {
+		partialKeys[strings.ToLower(k)] = true
+	}
+	for _, k := range mandatoryPartiallyRedactedKeys {
+		partialKeys[k] = true
+	}
+
+	buf := new(bytes.Buffer)
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(r.redactValue(v, "", keys, partialKeys)); err != nil {
+		return []byte(r.mask())
+	}
+	return bytes.TrimRight(buf.Bytes(), "\n")
+}
+
+// redactValue masks v, parent being the key v is found under.
+func (r *Redactor) redactValue(v interface{}, parent string, keys map[string]bool, partialKeys map[string]bool) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for k, elem := range value {
+			key := strings.ToLower(k)
+			if keys[key] || keys[parent+"."+key] {
+				if elem != nil {
+					value[k] = r.mask()
+				}
+				continue
+			}
+			if s, ok := elem.(string); ok && partialKeys[parent+"."+key] {
+				value[k] = r.partialMask(s)
+				continue
+			}
+			value[k] = r.redactValue(elem, key, keys, partialKeys)
+		}
+		return value
+	case []interface{}:
+		// elements of an array are qualified by the key of the array
+		for i, elem := range value {
+			value[i] = r.redactValue(elem, parent, keys, partialKeys)
+		}
+		return value
+	case string:
+		return r.redactString(value)
+	}
+	return v
+}
+
+func (r *Redactor) redactString(s string) string {
+	if r == nil {
+		return s
+	}
+	for _, pattern := range r.Patterns {
+		s = pattern.ReplaceAllString(s, r.mask())
+	}
+	return s
+}
+
+func (r *Redactor) partialMask(s string) string {
+	runes := []rune(s)
+	if len(runes) <= partialRedactionVisible {
+		return r.mask()
+	}
+	return r.mask() + string(runes[len(runes)-partialRedactionVisible:])
+}
+
+func (r *Redactor) mask() string {
+	if r.Mask == "" {
+		return defaultRedactionMask
+	}
+	return r.Mask
+}
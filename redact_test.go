@@ -0,0 +1,157 @@
+package goshopify
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestRedactorRedact(t *testing.T) {
+	cases := []struct {
+		redactor *Redactor
+		body     string
+		expected string
+	}{
+		{
+			NewRedactor(),
+			`{"access_token":"f85632530bf277ec9ac6f649fc327f17","scope":"write_orders"}`,
+			`{"access_token":"[REDACTED]","scope":"write_orders"}`,
+		},
+		{
+			NewRedactor(),
+			`{"customer":{"id":1,"email":"bob@example.com","phone":null,"note":"call +1 (613) 555-0100"}}`,
+			`{"customer":{"email":"[REDACTED]","id":1,"note":"call [REDACTED]","phone":null}}`,
+		},
+		{
+			NewRedactor(),
+			`{"order":{"id":450789469,"total_price":"409.94","billing_address":{"address1":"2259 Park Ct","zip":"K2P 1L4","city":"Ottawa"}}}`,
+			`{"order":{"billing_address":{"address1":"[REDACTED]","city":"[REDACTED]","zip":"[REDACTED]"},"id":450789469,"total_price":"409.94"}}`,
+		},
+		{
+			NewRedactor(),
+			`{"customer":{"id":1,"first_name":"Bob","last_name":"Norman","default_address":{"name":"Bob Norman","city":"Ottawa"},"addresses":[{"name":"Bob Norman","city":"Ottawa"}]}}`,
+			`{"customer":{"addresses":[{"city":"[REDACTED]","name":"[REDACTED]"}],"default_address":{"city":"[REDACTED]","name":"[REDACTED]"},"first_name":"[REDACTED]","id":1,"last_name":"[REDACTED]"}}`,
+		},
+		{
+			NewRedactor(),
+			`{"order":{"name":"#1001","line_items":[{"name":"IPod Nano - 8GB"}],"shipping_lines":[{"title":"Standard","name":"Standard"}],"location":{"name":"Ottawa Store","city":"Ottawa"}}}`,
+			`{"order":{"line_items":[{"name":"IPod Nano - 8GB"}],"location":{"city":"Ottawa","name":"Ottawa Store"},"name":"#1001","shipping_lines":[{"name":"Standard","title":"Standard"}]}}`,
+		},
+		{
+			NewRedactor(),
+			`{"order":{"id":450789469,"note":"call (613) 555-0100 or 613.555.0101, ref 4507894691234"}}`,
+			`{"order":{"id":450789469,"note":"call [REDACTED] or [REDACTED], ref 4507894691234"}}`,
+		},
+		{
+			NewRedactor(),
+			`https://fooshop.myshopify.com/admin/customers/search.json?query=email%3Abob%40example.com&limit=1`,
+			`https://fooshop.myshopify.com/admin/customers/search.json?query=[REDACTED]&limit=1`,
+		},
+		{
+			NewRedactor(),
+			`{"transactions":[{"id":1,"payment_details":{"credit_card_number":"•••• •••• •••• 4242"}}]}`,
+			`{"transactions":[{"id":1,"payment_details":"[REDACTED]"}]}`,
+		},
+		{
+			NewRedactor(),
+			`{"product":{"body_html":"<p>Write to sales@example.com</p>"}}`,
+			`{"product":{"body_html":"<p>Write to [REDACTED]</p>"}}`,
+		},
+		{
+			NewRedactor("note"),
+			`{"order":{"Note":"gift"}}`,
+			`{"order":{"Note":"[REDACTED]"}}`,
+		},
+		{
+			&Redactor{Keys: []string{"email"}, Mask: "***"},
+			`{"email":"bob@example.com","note":"bob@example.com"}`,
+			`{"email":"***","note":"bob@example.com"}`,
+		},
+		{
+			NewRedactor(),
+			`{"gift_card":{"code":"abcd efgh ijkl mnop","note":"code"},"discount_code":{"code":"SUMMER"}}`,
+			`{"discount_code":{"code":"SUMMER"},"gift_card":{"code":"[REDACTED]mnop","note":"code"}}`,
+		},
+		{
+			NewRedactor(),
+			`{"gift_cards":[{"code":"1234567890123456"},{"code":"abc"}]}`,
+			`{"gift_cards":[{"code":"[REDACTED]3456"},{"code":"[REDACTED]"}]}`,
+		},
+		{
+			NewRedactor(),
+			`not json, contact bob@example.com`,
+			`not json, contact [REDACTED]`,
+		},
+		{
+			nil,
+			`{"email":"bob@example.com"}`,
+			`{"email":"bob@example.com"}`,
+		},
+		{
+			nil,
+			`{"gift_card":{"code":"1234567890123456","note":"bob@example.com"}}`,
+			`{"gift_card":{"code":"[REDACTED]3456","note":"bob@example.com"}}`,
+		},
+		{
+			&Redactor{Mask: "***"},
+			`{"gift_cards":[{"code":"1234567890123456"}]}`,
+			`{"gift_cards":[{"code":"***3456"}]}`,
+		},
+		{
+			NewRedactor(),
+			`{"account_activation_url":"https://jsmith.myshopify.com/account/activate/1/a1b2c3"}`,
+			`{"account_activation_url":"[REDACTED]"}`,
+		},
+		{
+			nil,
+			`{"account_activation_url":"https://jsmith.myshopify.com/account/activate/1/a1b2c3"}`,
+			`{"account_activation_url":"[REDACTED]"}`,
+		},
+		{
+			&Redactor{Keys: []string{"email"}, Mask: "***"},
+			`{"account_activation_url":"https://jsmith.myshopify.com/account/activate/1/a1b2c3"}`,
+			`{"account_activation_url":"***"}`,
+		},
+	}
+
+	for _, c := range cases {
+		actual := string(c.redactor.Redact([]byte(c.body)))
+		if actual != c.expected {
+			t.Errorf("Redactor.Redact(%s) returned %s, expected %s", c.body, actual, c.expected)
+		}
+	}
+}
+
+func TestLogBodyRedacted(t *testing.T) {
+	out := &bytes.Buffer{}
+	logger := &LeveledLogger{Level: LevelDebug, stdoutOverride: out}
+
+	body := `{"customer":{"email":"bob@example.com"}}`
+	expected := "[DEBUG] RESP: {\"customer\":{\"email\":\"[REDACTED]\"}}\n"
+
+	client := NewClient(app, "fooshop", "abcd", WithLogger(logger))
+	client.logResponse(&http.Response{
+		Status:     http.StatusText(http.StatusOK),
+		StatusCode: http.StatusOK,
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+	})
+
+	if !strings.HasSuffix(out.String(), expected) {
+		t.Errorf("logResponse expected stdout \"%s\" received \"%s\"", expected, out.String())
+	}
+
+	out.Reset()
+	client = NewClient(app, "fooshop", "abcd", WithLogger(logger), WithRedactor(nil))
+	client.logResponse(&http.Response{
+		Status:     http.StatusText(http.StatusOK),
+		StatusCode: http.StatusOK,
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+	})
+
+	expected = "[DEBUG] RESP: " + body + "\n"
+	if !strings.HasSuffix(out.String(), expected) {
+		t.Errorf("logResponse expected stdout \"%s\" received \"%s\"", expected, out.String())
+	}
+}
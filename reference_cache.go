@@ -0,0 +1,101 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+// referenceCache caches the responses of the endpoints serving reference
+// data which rarely changes: countries, provinces, currencies and policies.
+// Responses are stored encoded, so every hit decodes a fresh copy the caller
+// is free to modify.
+type referenceCache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]referenceCacheEntry
+}
+
+type referenceCacheEntry struct {
+	body    []byte
+	expires time.Time
+}
+
+func newReferenceCache(ttl time.Duration) *referenceCache {
+	return &referenceCache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: map[string]referenceCacheEntry{},
+	}
+}
+
+// load decodes the cached response for key into resource, and reports
+// whether it was found and is not expired.
+func (rc *referenceCache) load(key string, resource interface{}) bool {
+	rc.mu.Lock()
+	entry, ok := rc.entries[key]
+	if ok && !rc.now().Before(entry.expires) {
+		delete(rc.entries, key)
+		ok = false
+	}
+	rc.mu.Unlock()
+
+	return ok && json.Unmarshal(entry.body, resource) == nil
+}
+
+func (rc *referenceCache) store(key string, resource interface{}) {
+	body, err := json.Marshal(resource)
+	if err != nil {
+		return
+	}
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.entries[key] = referenceCacheEntry{body: body, expires: rc.now().Add(rc.ttl)}
+}
+
+func (rc *referenceCache) purge() {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.entries = map[string]referenceCacheEntry{}
+}
+
+// getReference performs a GET request like Get, using the reference cache
+// when it is enabled with WithReferenceCache.
+func (c *Client) getReference(path string, resource, options interface{}) error {
+	if c.referenceCache == nil {
+		return c.Get(path, resource, options)
+	}
+
+	key := path
+	if options != nil {
+		optionsQuery, err := query.Values(options)
+		if err != nil {
+			return err
+		}
+		key += "?" + optionsQuery.Encode()
+	}
+
+	if c.referenceCache.load(key, resource) {
+		return nil
+	}
+
+	if err := c.Get(path, resource, options); err != nil {
+		return err
+	}
+	c.referenceCache.store(key, resource)
+	return nil
+}
+
+// PurgeReferenceCache empties the cache enabled with WithReferenceCache, e.g.
+// after the tax rates of the store were changed elsewhere. Writes made with
+// the client purge the cache already.
+func (c *Client) PurgeReferenceCache() {
+	if c.referenceCache != nil {
+		c.referenceCache.purge()
+	}
+}
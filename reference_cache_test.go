@@ -0,0 +1,77 @@
+package goshopify
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func TestReferenceCache(t *testing.T) {
+	setup()
+	defer teardown()
+
+	WithReferenceCache(time.Hour)(client)
+	now := time.Now()
+	client.referenceCache.now = func() time.Time { return now }
+
+	listURL := fmt.Sprintf("https://"+testHost+"/%s/countries.json", client.pathPrefix)
+	httpmock.RegisterResponder("GET", listURL,
+		httpmock.NewBytesResponder(200, loadFixture("countries.json")))
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://"+testHost+"/%s/countries/879921427.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("country.json")))
+
+	calls := func() int {
+		return httpmock.GetCallCountInfo()["GET "+listURL]
+	}
+
+	for i := 0; i < 2; i++ {
+		countries, err := client.Country.List(nil)
+		if err != nil {
+			t.Fatalf("Country.List returned error: %v", err)
+		}
+		if len(countries) != 1 {
+			t.Fatalf("Country.List got %d countries, expected 1", len(countries))
+		}
+		countryTests(t, countries[0])
+
+		// Cached copies are independent of each other
+		countries[0].Name = "changed"
+	}
+	if calls() != 1 {
+		t.Errorf("Country.List requested countries %d times, expected 1", calls())
+	}
+
+	// Different options are cached separately
+	_, err := client.Country.List(ListOptions{Limit: PInt(1)})
+	if err != nil {
+		t.Fatalf("Country.List returned error: %v", err)
+	}
+	if calls() != 2 {
+		t.Errorf("Country.List requested countries %d times, expected 2", calls())
+	}
+
+	// Expired entries are requested again
+	now = now.Add(time.Hour)
+	_, err = client.Country.List(nil)
+	if err != nil {
+		t.Fatalf("Country.List returned error: %v", err)
+	}
+	if calls() != 3 {
+		t.Errorf("Country.List requested countries %d times, expected 3", calls())
+	}
+
+	// Writes purge the cache
+	_, err = client.Country.Update(Country{ID: 879921427})
+	if err != nil {
+		t.Fatalf("Country.Update returned error: %v", err)
+	}
+	_, err = client.Country.List(nil)
+	if err != nil {
+		t.Fatalf("Country.List returned error: %v", err)
+	}
+	if calls() != 4 {
+		t.Errorf("Country.List requested countries %d times, expected 4", calls())
+	}
+}
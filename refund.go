@@ -0,0 +1,100 @@
+package goshopify
+
+import "fmt"
+
+const (
+	refundsResourceName = "refunds"
+
+	// kind of the transactions returned by a refund calculation
+	suggestedRefundKind = "suggested_refund"
+	refundKind          = "refund"
+)
+
+// RefundService is an interface for interfacing with the refunds endpoints of
+// the Shopify API.
+// See: https://shopify.dev/docs/api/admin-rest/2023-01/resources/refund
+type RefundService interface {
+	List(int64, interface{}) ([]Refund, error)
+	Get(int64, int64, interface{}) (*Refund, error)
+	Calculate(int64, Refund) (*Refund, error)
+	Create(int64, Refund) (*Refund, error)
+}
+
+// RefundServiceOp handles communication with the refund related methods of the
+// Shopify API.
+type RefundServiceOp struct {
+	client *Client
+}
+
+// RefundResource represents the result from the orders/X/refunds/Y.json endpoint
+type RefundResource struct {
+	Refund *Refund `json:"refund" bson:"refund"`
+}
+
+// RefundsResource represents the result from the orders/X/refunds.json endpoint
+type RefundsResource struct {
+	Refunds []Refund `json:"refunds" bson:"refunds"`
+}
+
+// List refunds of an order
+func (s *RefundServiceOp) List(orderID int64, options interface{}) ([]Refund, error) {
+	path := fmt.Sprintf("%s/%d/%s.json", ordersBasePath, orderID, refundsResourceName)
+	resource := new(RefundsResource)
+	err := s.client.Get(path, resource, options)
+	return resource.Refunds, err
+}
+
+// Get individual refund of an order
+func (s *RefundServiceOp) Get(orderID int64, refundID int64, options interface{}) (*Refund, error) {
+	path := fmt.Sprintf("%s/%d/%s/%d.json", ordersBasePath, orderID, refundsResourceName, refundID)
+	resource := new(RefundResource)
+	err := s.client.Get(path, resource, options)
+	return resource.Refund, err
+}
+
+// Calculate the refund for the given line items, shipping and duties. The
+// returned refund holds the suggested transactions and can be passed to
+// Create as it is.
+func (s *RefundServiceOp) Calculate(orderID int64, refund Refund) (*Refund, error) {
+	path := fmt.Sprintf("%s/%d/%s/calculate.json", ordersBasePath, orderID, refundsResourceName)
+	wrappedData := RefundResource{Refund: &refund}
+	resource := new(RefundResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.Refund, err
+}
+
+// Create a refund for an order. Suggested transactions from Calculate are
+// turned into refund transactions.
+func (s *RefundServiceOp) Create(orderID int64, refund Refund) (*Refund, error) {
+	path := fmt.Sprintf("%s/%d/%s.json", ordersBasePath, orderID, refundsResourceName)
+	refund = refundFromCalculation(refund)
+	wrappedData := RefundResource{Refund: &refund}
+	resource := new(RefundResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.Refund, err
+}
+
+// refundFromCalculation strips the fields of a calculated refund which are
+// only informational, and turns its suggested transactions into refunds.
+func refundFromCalculation(refund Refund) Refund {
+	if refund.Transactions != nil {
+		transactions := make([]Transaction, len(refund.Transactions))
+		for i, transaction := range refund.Transactions {
+			if transaction.Kind == suggestedRefundKind {
+				transaction.Kind = refundKind
+			}
+			transaction.MaximumRefundable = nil
+			transactions[i] = transaction
+		}
+		refund.Transactions = transactions
+	}
+
+	if refund.Shipping != nil {
+		shipping := *refund.Shipping
+		shipping.Tax = nil
+		shipping.MaximumRefundable = nil
+		refund.Shipping = &shipping
+	}
+
+	return refund
+}
@@ -0,0 +1,162 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func refundTests(t *testing.T, refund Refund) {
+	// Check that the ID is assigned to the returned refund
+	expectedID := int64(509562969)
+	if refund.Id != expectedID {
+		t.Errorf("Refund.Id returned %+v, expected %+v", refund.Id, expectedID)
+	}
+
+	// Check that the OrderID value is assigned to the returned refund
+	expectedOrderID := int64(450789469)
+	if refund.OrderId != expectedOrderID {
+		t.Errorf("Refund.OrderId returned %+v, expected %+v", refund.OrderId, expectedOrderID)
+	}
+
+	// Check that the Note value is assigned to the returned refund
+	expectedNote := "it broke during shipping"
+	if refund.Note == nil || *refund.Note != expectedNote {
+		t.Errorf("Refund.Note returned %+v, expected %+v", refund.Note, expectedNote)
+	}
+}
+
+func TestRefundList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/orders/450789469/refunds.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("refunds.json")))
+
+	refunds, err := client.Refund.List(450789469, nil)
+	if err != nil {
+		t.Errorf("Refund.List returned error: %v", err)
+	}
+
+	if len(refunds) != 1 {
+		t.Fatalf("Refund.List got %d refunds, expected 1", len(refunds))
+	}
+	refundTests(t, refunds[0])
+}
+
+func TestRefundGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/orders/450789469/refunds/509562969.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("refund.json")))
+
+	refund, err := client.Refund.Get(450789469, 509562969, nil)
+	if err != nil {
+		t.Errorf("Refund.Get returned error: %v", err)
+	}
+
+	refundTests(t, *refund)
+
+	if len(refund.Duties) != 1 || refund.Duties[0].DutyId != 1 {
+		t.Errorf("Refund.Duties returned %+v, expected duty 1", refund.Duties)
+	}
+
+	expectedSubtotal := decimal.RequireFromString("195.66")
+	if len(refund.RefundLineItems) != 1 || !refund.RefundLineItems[0].Subtotal.Equal(expectedSubtotal) {
+		t.Errorf("Refund.RefundLineItems returned %+v, expected subtotal %s", refund.RefundLineItems, expectedSubtotal)
+	}
+}
+
+func TestRefundCalculate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://"+testHost+"/%s/orders/450789469/refunds/calculate.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("refund_calculation.json")))
+
+	refund := Refund{
+		Shipping: &RefundShipping{FullRefund: true},
+		RefundLineItems: []RefundLineItem{
+			{LineItemId: 518995019, Quantity: 1, RestockType: "return"},
+		},
+		RefundDuties: []RefundDuty{{DutyId: 1, RefundType: "FULL"}},
+	}
+
+	calculated, err := client.Refund.Calculate(450789469, refund)
+	if err != nil {
+		t.Errorf("Refund.Calculate returned error: %v", err)
+	}
+
+	if len(calculated.Transactions) != 1 || calculated.Transactions[0].Kind != "suggested_refund" {
+		t.Fatalf("Refund.Calculate returned transactions %+v, expected a suggested refund", calculated.Transactions)
+	}
+
+	expectedMaximum := decimal.RequireFromString("41.94")
+	if !calculated.Transactions[0].MaximumRefundable.Equal(expectedMaximum) {
+		t.Errorf("Transaction.MaximumRefundable returned %v, expected %v", calculated.Transactions[0].MaximumRefundable, expectedMaximum)
+	}
+
+	expectedShipping := decimal.RequireFromString("5.00")
+	if calculated.Shipping == nil || !calculated.Shipping.MaximumRefundable.Equal(expectedShipping) {
+		t.Errorf("Refund.Shipping returned %+v, expected maximum refundable %v", calculated.Shipping, expectedShipping)
+	}
+}
+
+func TestRefundCreateFromCalculation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var sent struct {
+		Refund map[string]json.RawMessage `json:"refund"`
+	}
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://"+testHost+"/%s/orders/450789469/refunds.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			if err := json.Unmarshal(body, &sent); err != nil {
+				return nil, err
+			}
+			return httpmock.NewBytesResponse(201, loadFixture("refund.json")), nil
+		})
+
+	calculated := RefundResource{}
+	if err := json.Unmarshal(loadFixture("refund_calculation.json"), &calculated); err != nil {
+		t.Fatal(err)
+	}
+	calculated.Refund.Notify = true
+
+	refund, err := client.Refund.Create(450789469, *calculated.Refund)
+	if err != nil {
+		t.Errorf("Refund.Create returned error: %v", err)
+	}
+	refundTests(t, *refund)
+
+	var transactions []map[string]interface{}
+	if err := json.Unmarshal(sent.Refund["transactions"], &transactions); err != nil {
+		t.Fatal(err)
+	}
+	if len(transactions) != 1 || transactions[0]["kind"] != "refund" || transactions[0]["parent_id"] != float64(801038806) {
+		t.Errorf("Refund.Create sent transactions %v, expected a refund of parent 801038806", transactions)
+	}
+	if _, ok := transactions[0]["maximum_refundable"]; ok {
+		t.Errorf("Refund.Create sent maximum_refundable in transaction %v", transactions[0])
+	}
+
+	expectedShipping := `{"amount":"5"}`
+	if string(sent.Refund["shipping"]) != expectedShipping {
+		t.Errorf("Refund.Create sent shipping %s, expected %s", sent.Refund["shipping"], expectedShipping)
+	}
+	if string(sent.Refund["notify"]) != "true" {
+		t.Errorf("Refund.Create sent notify %s, expected true", sent.Refund["notify"])
+	}
+
+	// the calculated refund passed in is not modified
+	if calculated.Refund.Transactions[0].Kind != "suggested_refund" {
+		t.Errorf("Refund.Create modified the calculated transactions: %+v", calculated.Refund.Transactions)
+	}
+}
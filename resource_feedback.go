@@ -0,0 +1,153 @@
+package goshopify
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+)
+
+const resourceFeedbackBasePath = "resource_feedback"
+
+// ResourceFeedbackState is the state of a resource feedback
+type ResourceFeedbackState string
+
+// States of a resource feedback
+const (
+	ResourceFeedbackStateSuccess        ResourceFeedbackState = "success"
+	ResourceFeedbackStateRequiresAction ResourceFeedbackState = "requires_action"
+)
+
+// ResourceFeedbackService is an interface for interfacing with the resource
+// feedback endpoints of the Shopify API. Sales channel apps use it to report
+// problems with the setup of the shop or with its products to the merchant.
+// See: https://shopify.dev/docs/api/admin-rest/2023-01/resources/resourcefeedback
+type ResourceFeedbackService interface {
+	List() ([]ResourceFeedback, error)
+	Create(ResourceFeedback) (*ResourceFeedback, error)
+	ListForProduct(int64) ([]ResourceFeedback, error)
+	CreateForProduct(int64, ResourceFeedback) (*ResourceFeedback, error)
+	PublishProduct(int64, time.Time) (*ProductListing, error)
+}
+
+// ResourceFeedbackServiceOp handles communication with the resource feedback
+// related methods of the Shopify API.
+type ResourceFeedbackServiceOp struct {
+	client *Client
+}
+
+// ResourceFeedback represents the state of the shop or of a product for your
+// sales channel. Messages explain what the merchant must do and are required
+// when State is requires_action. Product feedback must have ResourceUpdatedAt
+// set to the UpdatedAt of the product it was generated for.
+type ResourceFeedback struct {
+	ResourceID          int64                 `json:"resource_id,omitempty" bson:"resource_id,omitempty"`
+	ResourceType        string                `json:"resource_type,omitempty" bson:"resource_type,omitempty"`
+	State               ResourceFeedbackState `json:"state,omitempty" bson:"state,omitempty"`
+	Messages            []string              `json:"messages,omitempty" bson:"messages,omitempty"`
+	FeedbackGeneratedAt *time.Time            `json:"feedback_generated_at,omitempty" bson:"feedback_generated_at,omitempty"`
+	ResourceUpdatedAt   *time.Time            `json:"resource_updated_at,omitempty" bson:"resource_updated_at,omitempty"`
+	CreatedAt           *time.Time            `json:"created_at,omitempty" bson:"created_at,omitempty"`
+	UpdatedAt           *time.Time            `json:"updated_at,omitempty" bson:"updated_at,omitempty"`
+}
+
+// ResourceFeedbackResource represents the result of creating a resource feedback
+type ResourceFeedbackResource struct {
+	ResourceFeedback *ResourceFeedback `json:"resource_feedback" bson:"resource_feedback"`
+}
+
+// ResourceFeedbacksResource represents the result from the resource_feedback.json endpoint
+type ResourceFeedbacksResource struct {
+	ResourceFeedback []ResourceFeedback `json:"resource_feedback" bson:"resource_feedback"`
+}
+
+// List the feedback of the shop
+func (s *ResourceFeedbackServiceOp) List() ([]ResourceFeedback, error) {
+	path := fmt.Sprintf("%s.json", resourceFeedbackBasePath)
+	resource := new(ResourceFeedbacksResource)
+	err := s.client.Get(path, resource, nil)
+	return resource.ResourceFeedback, err
+}
+
+// Create feedback for the shop, replacing the previous one
+func (s *ResourceFeedbackServiceOp) Create(feedback ResourceFeedback) (*ResourceFeedback, error) {
+	path := fmt.Sprintf("%s.json", resourceFeedbackBasePath)
+	wrappedData := ResourceFeedbackResource{ResourceFeedback: &feedback}
+	resource := new(ResourceFeedbackResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.ResourceFeedback, err
+}
+
+// ListForProduct lists the feedback of a product
+func (s *ResourceFeedbackServiceOp) ListForProduct(productID int64) ([]ResourceFeedback, error) {
+	path := fmt.Sprintf("%s/%d/%s.json", productsBasePath, productID, resourceFeedbackBasePath)
+	resource := new(ResourceFeedbacksResource)
+	err := s.client.Get(path, resource, nil)
+	return resource.ResourceFeedback, err
+}
+
+// CreateForProduct creates feedback for a product, replacing the previous one
+func (s *ResourceFeedbackServiceOp) CreateForProduct(productID int64, feedback ResourceFeedback) (*ResourceFeedback, error) {
+	path := fmt.Sprintf("%s/%d/%s.json", productsBasePath, productID, resourceFeedbackBasePath)
+	wrappedData := ResourceFeedbackResource{ResourceFeedback: &feedback}
+	resource := new(ResourceFeedbackResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.ResourceFeedback, err
+}
+
+// PublishProduct publishes a product to your sales channel with the
+// ProductListing service, and reports the outcome as feedback for the
+// product: success when it is published, requires_action with the
+// validation errors returned by Shopify when it is rejected. Other errors,
+// like rate limits, server errors or network failures, are returned without
+// reporting feedback, as they are no action for the merchant.
+// productUpdatedAt is the UpdatedAt of the product. When both publishing and
+// reporting fail, the error of publishing is returned wrapped with the one of
+// reporting.
+func (s *ResourceFeedbackServiceOp) PublishProduct(productID int64, productUpdatedAt time.Time) (*ProductListing, error) {
+	listing, publishErr := s.client.ProductListing.Publish(productID)
+
+	now := time.Now()
+	feedback := ResourceFeedback{
+		State:               ResourceFeedbackStateSuccess,
+		FeedbackGeneratedAt: &now,
+		ResourceUpdatedAt:   &productUpdatedAt,
+	}
+	if publishErr != nil {
+		messages, ok := resourceFeedbackMessages(publishErr)
+		if !ok {
+			return nil, publishErr
+		}
+		feedback.State = ResourceFeedbackStateRequiresAction
+		feedback.Messages = messages
+	}
+
+	_, feedbackErr := s.CreateForProduct(productID, feedback)
+	if publishErr != nil {
+		if feedbackErr != nil {
+			return nil, fmt.Errorf("%w (reporting feedback: %v)", publishErr, feedbackErr)
+		}
+		return nil, publishErr
+	}
+	return listing, feedbackErr
+}
+
+// resourceFeedbackMessages returns the messages to show the merchant for a
+// validation error, and false for any other error
+func resourceFeedbackMessages(err error) ([]string, bool) {
+	var responseError ResponseError
+	if !errors.As(err, &responseError) {
+		return nil, false
+	}
+	if responseError.Status != http.StatusUnprocessableEntity && len(responseError.Fields) == 0 {
+		return nil, false
+	}
+
+	messages := append([]string(nil), responseError.Errors...)
+	if len(messages) == 0 && responseError.Message != "" {
+		messages = []string{responseError.Message}
+	}
+	sort.Strings(messages)
+	return messages, true
+}
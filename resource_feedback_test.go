@@ -0,0 +1,171 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func TestResourceFeedbackList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/resource_feedback.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"resource_feedback": [{"resource_id": 548380009, "resource_type": "Shop", "state": "requires_action", "messages": ["is not connected. Connect your account to use this sales channel."]}]}`))
+
+	feedback, err := client.ResourceFeedback.List()
+	if err != nil {
+		t.Errorf("ResourceFeedback.List returned error: %v", err)
+	}
+
+	expected := []ResourceFeedback{{
+		ResourceID:   548380009,
+		ResourceType: "Shop",
+		State:        ResourceFeedbackStateRequiresAction,
+		Messages:     []string{"is not connected. Connect your account to use this sales channel."},
+	}}
+	if !reflect.DeepEqual(feedback, expected) {
+		t.Errorf("ResourceFeedback.List returned %+v, expected %+v", feedback, expected)
+	}
+}
+
+func TestResourceFeedbackCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://"+testHost+"/%s/resource_feedback.json", client.pathPrefix),
+		httpmock.NewStringResponder(202, `{"resource_feedback": {"resource_id": 548380009, "resource_type": "Shop", "state": "success", "messages": []}}`))
+
+	feedback, err := client.ResourceFeedback.Create(ResourceFeedback{State: ResourceFeedbackStateSuccess})
+	if err != nil {
+		t.Errorf("ResourceFeedback.Create returned error: %v", err)
+	}
+
+	if feedback == nil || feedback.ResourceType != "Shop" || feedback.State != ResourceFeedbackStateSuccess {
+		t.Errorf("ResourceFeedback.Create returned %+v, expected success for the shop", feedback)
+	}
+}
+
+func TestResourceFeedbackListForProduct(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/products/632910392/resource_feedback.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"resource_feedback": [{"resource_id": 632910392, "resource_type": "Product", "state": "success", "resource_updated_at": "2023-01-03T12:55:23-05:00"}]}`))
+
+	feedback, err := client.ResourceFeedback.ListForProduct(632910392)
+	if err != nil {
+		t.Errorf("ResourceFeedback.ListForProduct returned error: %v", err)
+	}
+
+	if len(feedback) != 1 || feedback[0].ResourceID != 632910392 || feedback[0].ResourceUpdatedAt == nil {
+		t.Errorf("ResourceFeedback.ListForProduct returned %+v, expected the feedback of product 632910392", feedback)
+	}
+}
+
+func TestResourceFeedbackCreateForProduct(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://"+testHost+"/%s/products/632910392/resource_feedback.json", client.pathPrefix),
+		httpmock.NewStringResponder(202, `{"resource_feedback": {"resource_id": 632910392, "resource_type": "Product", "state": "requires_action", "messages": ["Needs at least one image."]}}`))
+
+	updatedAt := time.Date(2023, time.January, 3, 17, 55, 23, 0, time.UTC)
+	feedback, err := client.ResourceFeedback.CreateForProduct(632910392, ResourceFeedback{
+		State:             ResourceFeedbackStateRequiresAction,
+		Messages:          []string{"Needs at least one image."},
+		ResourceUpdatedAt: &updatedAt,
+	})
+	if err != nil {
+		t.Errorf("ResourceFeedback.CreateForProduct returned error: %v", err)
+	}
+
+	if feedback == nil || feedback.ResourceID != 632910392 || feedback.State != ResourceFeedbackStateRequiresAction {
+		t.Errorf("ResourceFeedback.CreateForProduct returned %+v, expected requires_action for product 632910392", feedback)
+	}
+}
+
+func TestResourceFeedbackPublishProduct(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var sent []ResourceFeedback
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://"+testHost+"/%s/products/921728736/resource_feedback.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			resource := new(ResourceFeedbackResource)
+			if err := json.NewDecoder(req.Body).Decode(resource); err != nil {
+				return nil, err
+			}
+			sent = append(sent, *resource.ResourceFeedback)
+			return httpmock.NewJsonResponse(202, resource)
+		})
+
+	publishURL := fmt.Sprintf("https://"+testHost+"/%s/product_listings/921728736.json", client.pathPrefix)
+	updatedAt := time.Date(2023, time.January, 3, 17, 55, 23, 0, time.UTC)
+
+	// Published
+	httpmock.RegisterResponder("PUT", publishURL, httpmock.NewBytesResponder(200, loadFixture("product_listing.json")))
+	listing, err := client.ResourceFeedback.PublishProduct(921728736, updatedAt)
+	if err != nil {
+		t.Fatalf("ResourceFeedback.PublishProduct returned error: %v", err)
+	}
+	if listing == nil || listing.Handle == "" || listing.ID != 921728736 {
+		t.Errorf("ResourceFeedback.PublishProduct returned %+v, expected the product listing of product 921728736", listing)
+	}
+
+	// Rejected
+	httpmock.RegisterResponder("PUT", publishURL,
+		httpmock.NewStringResponder(422, `{"errors": {"images": ["must have at least one image"], "body_html": ["can't be blank"]}}`))
+	listing, err = client.ResourceFeedback.PublishProduct(921728736, updatedAt)
+	if err == nil || listing != nil {
+		t.Errorf("ResourceFeedback.PublishProduct returned %+v, expected an error", listing)
+	}
+
+	// Transient errors are no action for the merchant
+	transient := []httpmock.Responder{
+		httpmock.NewStringResponder(429, `{"errors": "Exceeded 2 calls per second for api client. Reduce request rates to resume uninterrupted service."}`),
+		httpmock.NewStringResponder(503, `{"errors": "Unavailable Shop"}`),
+		httpmock.NewErrorResponder(errors.New("connection reset by peer")),
+	}
+	for _, responder := range transient {
+		httpmock.RegisterResponder("PUT", publishURL, responder)
+		listing, err = client.ResourceFeedback.PublishProduct(921728736, updatedAt)
+		if err == nil || listing != nil {
+			t.Errorf("ResourceFeedback.PublishProduct returned %+v, expected an error", listing)
+		}
+	}
+
+	if len(sent) != 2 {
+		t.Fatalf("ResourceFeedback.PublishProduct sent %d feedback, expected 2", len(sent))
+	}
+	for _, feedback := range sent {
+		if feedback.ResourceUpdatedAt == nil || !feedback.ResourceUpdatedAt.Equal(updatedAt) || feedback.FeedbackGeneratedAt == nil {
+			t.Errorf("ResourceFeedback.PublishProduct sent %+v, expected the product update time", feedback)
+		}
+	}
+	if sent[0].State != ResourceFeedbackStateSuccess || len(sent[0].Messages) != 0 {
+		t.Errorf("ResourceFeedback.PublishProduct sent %+v, expected success", sent[0])
+	}
+	expectedMessages := []string{"body_html: can't be blank", "images: must have at least one image"}
+	if sent[1].State != ResourceFeedbackStateRequiresAction || !reflect.DeepEqual(sent[1].Messages, expectedMessages) {
+		t.Errorf("ResourceFeedback.PublishProduct sent %+v, expected requires_action with %v", sent[1], expectedMessages)
+	}
+
+	// Failing to report feedback does not hide why publishing failed
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://"+testHost+"/%s/products/921728736/resource_feedback.json", client.pathPrefix),
+		httpmock.NewStringResponder(500, `{"errors": "Internal Server Error"}`))
+	httpmock.RegisterResponder("PUT", publishURL,
+		httpmock.NewStringResponder(422, `{"errors": {"images": ["must have at least one image"]}}`))
+	_, err = client.ResourceFeedback.PublishProduct(921728736, updatedAt)
+	var respErr ResponseError
+	if !errors.As(err, &respErr) || respErr.Status != 422 || !strings.Contains(err.Error(), "reporting feedback") {
+		t.Errorf("ResourceFeedback.PublishProduct returned error %v, expected the 422 of publishing wrapped with the feedback error", err)
+	}
+}
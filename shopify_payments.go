@@ -0,0 +1,307 @@
+package goshopify
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const shopifyPaymentsBasePath = "shopify_payments"
+
+// ErrNoSourceTransaction is returned by GetBalanceTransactionSource and
+// GetBalanceTransactionOrder for balance transactions which don't originate
+// from an order transaction, e.g. payout adjustments
+var ErrNoSourceTransaction = errors.New("balance transaction has no source order transaction")
+
+// ShopifyPaymentsService is an interface for interfacing with the Shopify
+// Payments endpoints of the Shopify API.
+// See: https://shopify.dev/docs/api/admin-rest/2023-01/resources/payouts
+type ShopifyPaymentsService interface {
+	GetBalance() ([]PaymentsBalance, error)
+	ListBalanceTransactions(interface{}) ([]BalanceTransaction, error)
+	ListBalanceTransactionsWithPagination(interface{}) ([]BalanceTransaction, *Pagination, error)
+	GetBalanceTransactionSource(BalanceTransaction) (*Transaction, error)
+	GetBalanceTransactionOrder(BalanceTransaction, interface{}) (*Order, error)
+	ListPayouts(interface{}) ([]Payout, error)
+	ListPayoutsWithPagination(interface{}) ([]Payout, *Pagination, error)
+	GetPayout(int64) (*Payout, error)
+	ListDisputes(interface{}) ([]Dispute, error)
+	ListDisputesWithPagination(interface{}) ([]Dispute, *Pagination, error)
+	GetDispute(int64) (*Dispute, error)
+}
+
+// ShopifyPaymentsServiceOp handles communication with the Shopify Payments
+// related methods of the Shopify API.
+type ShopifyPaymentsServiceOp struct {
+	client *Client
+}
+
+// PaymentsBalance represents the Shopify Payments balance of the shop in one
+// currency
+type PaymentsBalance struct {
+	Currency string           `json:"currency,omitempty" bson:"currency,omitempty"`
+	Amount   *decimal.Decimal `json:"amount,omitempty" bson:"amount,omitempty"`
+}
+
+// BalanceTransaction represents a movement of money in the Shopify Payments
+// balance, e.g. a charge, a refund or a payout
+type BalanceTransaction struct {
+	ID                       int64            `json:"id,omitempty" bson:"id,omitempty"`
+	Type                     string           `json:"type,omitempty" bson:"type,omitempty"`
+	Test                     bool             `json:"test,omitempty" bson:"test,omitempty"`
+	PayoutID                 int64            `json:"payout_id,omitempty" bson:"payout_id,omitempty"`
+	PayoutStatus             string           `json:"payout_status,omitempty" bson:"payout_status,omitempty"`
+	Currency                 string           `json:"currency,omitempty" bson:"currency,omitempty"`
+	Amount                   *decimal.Decimal `json:"amount,omitempty" bson:"amount,omitempty"`
+	Fee                      *decimal.Decimal `json:"fee,omitempty" bson:"fee,omitempty"`
+	Net                      *decimal.Decimal `json:"net,omitempty" bson:"net,omitempty"`
+	SourceID                 int64            `json:"source_id,omitempty" bson:"source_id,omitempty"`
+	SourceType               string           `json:"source_type,omitempty" bson:"source_type,omitempty"`
+	SourceOrderID            int64            `json:"source_order_id,omitempty" bson:"source_order_id,omitempty"`
+	SourceOrderTransactionID int64            `json:"source_order_transaction_id,omitempty" bson:"source_order_transaction_id,omitempty"`
+	AdjustmentReason         string           `json:"adjustment_reason,omitempty" bson:"adjustment_reason,omitempty"`
+	ProcessedAt              *time.Time       `json:"processed_at,omitempty" bson:"processed_at,omitempty"`
+}
+
+// BalanceTransactionListOptions represents the options available when listing
+// balance transactions
+type BalanceTransactionListOptions struct {
+	ListOptions
+	LastID       *int64 `url:"last_id,omitempty"`
+	PayoutID     int64  `url:"payout_id,omitempty"`
+	PayoutStatus string `url:"payout_status,omitempty"`
+	Test         *bool  `url:"test,omitempty"`
+}
+
+// Payout represents a transfer of money between the Shopify Payments balance
+// and the bank account of the shop. Date is formatted as YYYY-MM-DD.
+type Payout struct {
+	ID       int64            `json:"id,omitempty" bson:"id,omitempty"`
+	Status   string           `json:"status,omitempty" bson:"status,omitempty"`
+	Date     string           `json:"date,omitempty" bson:"date,omitempty"`
+	Currency string           `json:"currency,omitempty" bson:"currency,omitempty"`
+	Amount   *decimal.Decimal `json:"amount,omitempty" bson:"amount,omitempty"`
+	Summary  *PayoutSummary   `json:"summary,omitempty" bson:"summary,omitempty"`
+}
+
+// PayoutSummary represents the breakdown of a payout by type of balance
+// transaction
+type PayoutSummary struct {
+	AdjustmentsFeeAmount      *decimal.Decimal `json:"adjustments_fee_amount,omitempty" bson:"adjustments_fee_amount,omitempty"`
+	AdjustmentsGrossAmount    *decimal.Decimal `json:"adjustments_gross_amount,omitempty" bson:"adjustments_gross_amount,omitempty"`
+	ChargesFeeAmount          *decimal.Decimal `json:"charges_fee_amount,omitempty" bson:"charges_fee_amount,omitempty"`
+	ChargesGrossAmount        *decimal.Decimal `json:"charges_gross_amount,omitempty" bson:"charges_gross_amount,omitempty"`
+	RefundsFeeAmount          *decimal.Decimal `json:"refunds_fee_amount,omitempty" bson:"refunds_fee_amount,omitempty"`
+	RefundsGrossAmount        *decimal.Decimal `json:"refunds_gross_amount,omitempty" bson:"refunds_gross_amount,omitempty"`
+	ReservedFundsFeeAmount    *decimal.Decimal `json:"reserved_funds_fee_amount,omitempty" bson:"reserved_funds_fee_amount,omitempty"`
+	ReservedFundsGrossAmount  *decimal.Decimal `json:"reserved_funds_gross_amount,omitempty" bson:"reserved_funds_gross_amount,omitempty"`
+	RetriedPayoutsFeeAmount   *decimal.Decimal `json:"retried_payouts_fee_amount,omitempty" bson:"retried_payouts_fee_amount,omitempty"`
+	RetriedPayoutsGrossAmount *decimal.Decimal `json:"retried_payouts_gross_amount,omitempty" bson:"retried_payouts_gross_amount,omitempty"`
+}
+
+// PayoutListOptions represents the options available when listing payouts.
+// Dates are formatted as YYYY-MM-DD.
+type PayoutListOptions struct {
+	ListOptions
+	LastID  *int64 `url:"last_id,omitempty"`
+	Status  string `url:"status,omitempty"`
+	Date    string `url:"date,omitempty"`
+	DateMin string `url:"date_min,omitempty"`
+	DateMax string `url:"date_max,omitempty"`
+}
+
+// Dispute represents a chargeback or an inquiry opened by the customer's bank
+// against a payment
+type Dispute struct {
+	ID                int64            `json:"id,omitempty" bson:"id,omitempty"`
+	OrderID           int64            `json:"order_id,omitempty" bson:"order_id,omitempty"`
+	Type              string           `json:"type,omitempty" bson:"type,omitempty"`
+	Currency          string           `json:"currency,omitempty" bson:"currency,omitempty"`
+	Amount            *decimal.Decimal `json:"amount,omitempty" bson:"amount,omitempty"`
+	Reason            string           `json:"reason,omitempty" bson:"reason,omitempty"`
+	NetworkReasonCode string           `json:"network_reason_code,omitempty" bson:"network_reason_code,omitempty"`
+	Status            string           `json:"status,omitempty" bson:"status,omitempty"`
+	EvidenceDueBy     *time.Time       `json:"evidence_due_by,omitempty" bson:"evidence_due_by,omitempty"`
+	EvidenceSentOn    *time.Time       `json:"evidence_sent_on,omitempty" bson:"evidence_sent_on,omitempty"`
+	FinalizedOn       *time.Time       `json:"finalized_on,omitempty" bson:"finalized_on,omitempty"`
+	InitiatedAt       *time.Time       `json:"initiated_at,omitempty" bson:"initiated_at,omitempty"`
+}
+
+// DisputeListOptions represents the options available when listing disputes.
+// InitiatedAt is formatted as YYYY-MM-DD.
+type DisputeListOptions struct {
+	ListOptions
+	LastID      *int64 `url:"last_id,omitempty"`
+	Status      string `url:"status,omitempty"`
+	InitiatedAt string `url:"initiated_at,omitempty"`
+}
+
+// PaymentsBalanceResource represents the result from the shopify_payments/balance.json endpoint
+type PaymentsBalanceResource struct {
+	Balance []PaymentsBalance `json:"balance" bson:"balance"`
+}
+
+// BalanceTransactionsResource represents the result from the shopify_payments/balance/transactions.json endpoint
+type BalanceTransactionsResource struct {
+	Transactions []BalanceTransaction `json:"transactions" bson:"transactions"`
+}
+
+// PayoutResource represents the result from the shopify_payments/payouts/X.json endpoint
+type PayoutResource struct {
+	Payout *Payout `json:"payout" bson:"payout"`
+}
+
+// PayoutsResource represents the result from the shopify_payments/payouts.json endpoint
+type PayoutsResource struct {
+	Payouts []Payout `json:"payouts" bson:"payouts"`
+}
+
+// DisputeResource represents the result from the shopify_payments/disputes/X.json endpoint
+type DisputeResource struct {
+	Dispute *Dispute `json:"dispute" bson:"dispute"`
+}
+
+// DisputesResource represents the result from the shopify_payments/disputes.json endpoint
+type DisputesResource struct {
+	Disputes []Dispute `json:"disputes" bson:"disputes"`
+}
+
+// GetBalance gets the current balance of the shop, per currency
+func (s *ShopifyPaymentsServiceOp) GetBalance() ([]PaymentsBalance, error) {
+	path := fmt.Sprintf("%s/balance.json", shopifyPaymentsBasePath)
+	resource := new(PaymentsBalanceResource)
+	err := s.client.Get(path, resource, nil)
+	return resource.Balance, err
+}
+
+// ListBalanceTransactions lists the transactions of the balance
+func (s *ShopifyPaymentsServiceOp) ListBalanceTransactions(options interface{}) ([]BalanceTransaction, error) {
+	transactions, _, err := s.ListBalanceTransactionsWithPagination(options)
+	if err != nil {
+		return nil, err
+	}
+	return transactions, nil
+}
+
+// ListBalanceTransactionsWithPagination lists the transactions of the balance and return pagination to retrieve next/previous results.
+func (s *ShopifyPaymentsServiceOp) ListBalanceTransactionsWithPagination(options interface{}) ([]BalanceTransaction, *Pagination, error) {
+	path := fmt.Sprintf("%s/balance/transactions.json", shopifyPaymentsBasePath)
+	resource := new(BalanceTransactionsResource)
+	headers := http.Header{}
+
+	headers, err := s.client.createAndDoGetHeaders("GET", path, nil, options, resource)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Extract pagination info from header
+	linkHeader := headers.Get("Link")
+
+	pagination, err := extractPagination(linkHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource.Transactions, pagination, nil
+}
+
+// GetBalanceTransactionSource gets the order transaction a balance
+// transaction originates from, with the Transaction service. It returns
+// ErrNoSourceTransaction for balance transactions without one.
+func (s *ShopifyPaymentsServiceOp) GetBalanceTransactionSource(transaction BalanceTransaction) (*Transaction, error) {
+	if transaction.SourceOrderID == 0 || transaction.SourceOrderTransactionID == 0 {
+		return nil, ErrNoSourceTransaction
+	}
+	return s.client.Transaction.Get(transaction.SourceOrderID, transaction.SourceOrderTransactionID, nil)
+}
+
+// GetBalanceTransactionOrder gets the order a balance transaction originates
+// from, with the Order service. It returns ErrNoSourceTransaction for
+// balance transactions without one.
+func (s *ShopifyPaymentsServiceOp) GetBalanceTransactionOrder(transaction BalanceTransaction, options interface{}) (*Order, error) {
+	if transaction.SourceOrderID == 0 {
+		return nil, ErrNoSourceTransaction
+	}
+	return s.client.Order.Get(transaction.SourceOrderID, options)
+}
+
+// ListPayouts lists the payouts of the shop, most recent first
+func (s *ShopifyPaymentsServiceOp) ListPayouts(options interface{}) ([]Payout, error) {
+	payouts, _, err := s.ListPayoutsWithPagination(options)
+	if err != nil {
+		return nil, err
+	}
+	return payouts, nil
+}
+
+// ListPayoutsWithPagination lists the payouts of the shop and return pagination to retrieve next/previous results.
+func (s *ShopifyPaymentsServiceOp) ListPayoutsWithPagination(options interface{}) ([]Payout, *Pagination, error) {
+	path := fmt.Sprintf("%s/payouts.json", shopifyPaymentsBasePath)
+	resource := new(PayoutsResource)
+	headers := http.Header{}
+
+	headers, err := s.client.createAndDoGetHeaders("GET", path, nil, options, resource)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Extract pagination info from header
+	linkHeader := headers.Get("Link")
+
+	pagination, err := extractPagination(linkHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource.Payouts, pagination, nil
+}
+
+// GetPayout gets an individual payout
+func (s *ShopifyPaymentsServiceOp) GetPayout(payoutID int64) (*Payout, error) {
+	path := fmt.Sprintf("%s/payouts/%d.json", shopifyPaymentsBasePath, payoutID)
+	resource := new(PayoutResource)
+	err := s.client.Get(path, resource, nil)
+	return resource.Payout, err
+}
+
+// ListDisputes lists the disputes of the shop, most recent first
+func (s *ShopifyPaymentsServiceOp) ListDisputes(options interface{}) ([]Dispute, error) {
+	disputes, _, err := s.ListDisputesWithPagination(options)
+	if err != nil {
+		return nil, err
+	}
+	return disputes, nil
+}
+
+// ListDisputesWithPagination lists the disputes of the shop and return pagination to retrieve next/previous results.
+func (s *ShopifyPaymentsServiceOp) ListDisputesWithPagination(options interface{}) ([]Dispute, *Pagination, error) {
+	path := fmt.Sprintf("%s/disputes.json", shopifyPaymentsBasePath)
+	resource := new(DisputesResource)
+	headers := http.Header{}
+
+	headers, err := s.client.createAndDoGetHeaders("GET", path, nil, options, resource)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Extract pagination info from header
+	linkHeader := headers.Get("Link")
+
+	pagination, err := extractPagination(linkHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource.Disputes, pagination, nil
+}
+
+// GetDispute gets an individual dispute
+func (s *ShopifyPaymentsServiceOp) GetDispute(disputeID int64) (*Dispute, error) {
+	path := fmt.Sprintf("%s/disputes/%d.json", shopifyPaymentsBasePath, disputeID)
+	resource := new(DisputeResource)
+	err := s.client.Get(path, resource, nil)
+	return resource.Dispute, err
+}
@@ -0,0 +1,298 @@
+package goshopify
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func payoutTests(t *testing.T, payout Payout) {
+	// Check that ID is assigned to the returned payout
+	expectedID := int64(623721858)
+	if payout.ID != expectedID {
+		t.Errorf("Payout.ID returned %+v, expected %+v", payout.ID, expectedID)
+	}
+
+	if payout.Status != "paid" || payout.Date != "2012-11-12" {
+		t.Errorf("Payout returned %s on %s, expected paid on 2012-11-12", payout.Status, payout.Date)
+	}
+
+	expectedAmount := decimal.RequireFromString("41.90")
+	if payout.Amount == nil || !payout.Amount.Equal(expectedAmount) {
+		t.Errorf("Payout.Amount returned %v, expected %v", payout.Amount, expectedAmount)
+	}
+
+	expectedRefunds := decimal.RequireFromString("-3.54")
+	if payout.Summary == nil || payout.Summary.RefundsGrossAmount == nil || !payout.Summary.RefundsGrossAmount.Equal(expectedRefunds) {
+		t.Errorf("Payout.Summary returned %+v, expected refunds gross amount %v", payout.Summary, expectedRefunds)
+	}
+}
+
+func disputeTests(t *testing.T, dispute Dispute) {
+	// Check that ID is assigned to the returned dispute
+	expectedID := int64(598735659)
+	if dispute.ID != expectedID {
+		t.Errorf("Dispute.ID returned %+v, expected %+v", dispute.ID, expectedID)
+	}
+
+	if dispute.OrderID != 625362839 || dispute.Reason != "fraudulent" || dispute.Status != "needs_response" {
+		t.Errorf("Dispute returned %+v, expected a fraudulent dispute needing response for order 625362839", dispute)
+	}
+
+	expectedAmount := decimal.RequireFromString("11.50")
+	if dispute.Amount == nil || !dispute.Amount.Equal(expectedAmount) {
+		t.Errorf("Dispute.Amount returned %v, expected %v", dispute.Amount, expectedAmount)
+	}
+
+	expectedEvidenceDueBy := time.Date(2013, time.July, 3, 23, 0, 0, 0, time.UTC)
+	if dispute.EvidenceDueBy == nil || !dispute.EvidenceDueBy.Equal(expectedEvidenceDueBy) {
+		t.Errorf("Dispute.EvidenceDueBy returned %v, expected %v", dispute.EvidenceDueBy, expectedEvidenceDueBy)
+	}
+	if dispute.EvidenceSentOn != nil {
+		t.Errorf("Dispute.EvidenceSentOn returned %v, expected nil", dispute.EvidenceSentOn)
+	}
+}
+
+func TestShopifyPaymentsGetBalance(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/shopify_payments/balance.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"balance": [{"currency": "USD", "amount": "53.99"}]}`))
+
+	balance, err := client.ShopifyPayments.GetBalance()
+	if err != nil {
+		t.Errorf("ShopifyPayments.GetBalance returned error: %v", err)
+	}
+
+	amount := decimal.RequireFromString("53.99")
+	expected := []PaymentsBalance{{Currency: "USD", Amount: &amount}}
+	if len(balance) != 1 || balance[0].Currency != "USD" || !balance[0].Amount.Equal(amount) {
+		t.Errorf("ShopifyPayments.GetBalance returned %+v, expected %+v", balance, expected)
+	}
+}
+
+func TestShopifyPaymentsListBalanceTransactions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponderWithQuery("GET", fmt.Sprintf("https://"+testHost+"/%s/shopify_payments/balance/transactions.json", client.pathPrefix),
+		map[string]string{"payout_id": "623721858", "test": "false"},
+		httpmock.NewBytesResponder(200, loadFixture("balance_transactions.json")))
+
+	options := BalanceTransactionListOptions{PayoutID: 623721858, Test: new(bool)}
+	transactions, err := client.ShopifyPayments.ListBalanceTransactions(options)
+	if err != nil {
+		t.Errorf("ShopifyPayments.ListBalanceTransactions returned error: %v", err)
+	}
+
+	if len(transactions) != 2 {
+		t.Fatalf("ShopifyPayments.ListBalanceTransactions got %d transactions, expected 2", len(transactions))
+	}
+
+	charge := transactions[1]
+	if charge.Type != "charge" || charge.SourceOrderID != 217130470 || charge.SourceOrderTransactionID != 1006917261 {
+		t.Errorf("ShopifyPayments.ListBalanceTransactions returned %+v, expected the charge of order 217130470", charge)
+	}
+
+	expectedNet := decimal.RequireFromString("99.09")
+	if charge.Net == nil || !charge.Net.Equal(expectedNet) {
+		t.Errorf("BalanceTransaction.Net returned %v, expected %v", charge.Net, expectedNet)
+	}
+}
+
+func TestShopifyPaymentsListBalanceTransactionsWithPagination(t *testing.T) {
+	setup()
+	defer teardown()
+
+	response := httpmock.NewBytesResponse(200, loadFixture("balance_transactions.json"))
+	response.Header.Set("Link", `<http://valid.url?page_info=foo&limit=2>; rel="next"`)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/shopify_payments/balance/transactions.json", client.pathPrefix),
+		httpmock.ResponderFromResponse(response))
+
+	transactions, pagination, err := client.ShopifyPayments.ListBalanceTransactionsWithPagination(nil)
+	if err != nil {
+		t.Errorf("ShopifyPayments.ListBalanceTransactionsWithPagination returned error: %v", err)
+	}
+
+	if len(transactions) != 2 {
+		t.Fatalf("ShopifyPayments.ListBalanceTransactionsWithPagination got %d transactions, expected 2", len(transactions))
+	}
+
+	expectedPagination := &Pagination{
+		NextPageOptions: &ListOptions{PageInfo: PString("foo"), Limit: PInt(2)},
+	}
+	if !reflect.DeepEqual(pagination, expectedPagination) {
+		t.Errorf("ShopifyPayments.ListBalanceTransactionsWithPagination returned pagination %+v, expected %+v", pagination, expectedPagination)
+	}
+}
+
+func TestShopifyPaymentsGetBalanceTransactionSource(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/orders/217130470/transactions/1006917261.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("transaction.json")))
+
+	charge := BalanceTransaction{ID: 77412310, SourceOrderID: 217130470, SourceOrderTransactionID: 1006917261}
+	transaction, err := client.ShopifyPayments.GetBalanceTransactionSource(charge)
+	if err != nil {
+		t.Fatalf("ShopifyPayments.GetBalanceTransactionSource returned error: %v", err)
+	}
+	TransactionTests(t, *transaction)
+
+	adjustment := BalanceTransaction{ID: 699519475, SourceType: "adjustment"}
+	_, err = client.ShopifyPayments.GetBalanceTransactionSource(adjustment)
+	if err != ErrNoSourceTransaction {
+		t.Errorf("ShopifyPayments.GetBalanceTransactionSource returned error %v, expected %v", err, ErrNoSourceTransaction)
+	}
+}
+
+func TestShopifyPaymentsGetBalanceTransactionOrder(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponderWithQuery("GET", fmt.Sprintf("https://"+testHost+"/%s/orders/217130470.json", client.pathPrefix),
+		map[string]string{"fields": "id,name"},
+		httpmock.NewStringResponder(200, `{"order":{"id":217130470,"name":"#1001"}}`))
+
+	charge := BalanceTransaction{ID: 77412310, SourceOrderID: 217130470, SourceOrderTransactionID: 1006917261}
+	order, err := client.ShopifyPayments.GetBalanceTransactionOrder(charge, ListOptions{Fields: PString("id,name")})
+	if err != nil {
+		t.Fatalf("ShopifyPayments.GetBalanceTransactionOrder returned error: %v", err)
+	}
+	if order.ID != 217130470 || order.Name != "#1001" {
+		t.Errorf("ShopifyPayments.GetBalanceTransactionOrder returned %+v, expected order 217130470", order)
+	}
+
+	adjustment := BalanceTransaction{ID: 699519475, SourceType: "adjustment"}
+	_, err = client.ShopifyPayments.GetBalanceTransactionOrder(adjustment, nil)
+	if err != ErrNoSourceTransaction {
+		t.Errorf("ShopifyPayments.GetBalanceTransactionOrder returned error %v, expected %v", err, ErrNoSourceTransaction)
+	}
+}
+
+func TestShopifyPaymentsListPayouts(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponderWithQuery("GET", fmt.Sprintf("https://"+testHost+"/%s/shopify_payments/payouts.json", client.pathPrefix),
+		map[string]string{"status": "paid", "date_min": "2012-11-01", "date_max": "2012-11-30"},
+		httpmock.NewBytesResponder(200, loadFixture("payouts.json")))
+
+	options := PayoutListOptions{Status: "paid", DateMin: "2012-11-01", DateMax: "2012-11-30"}
+	payouts, err := client.ShopifyPayments.ListPayouts(options)
+	if err != nil {
+		t.Errorf("ShopifyPayments.ListPayouts returned error: %v", err)
+	}
+
+	if len(payouts) != 1 {
+		t.Fatalf("ShopifyPayments.ListPayouts got %d payouts, expected 1", len(payouts))
+	}
+	payoutTests(t, payouts[0])
+}
+
+func TestShopifyPaymentsListPayoutsWithPagination(t *testing.T) {
+	setup()
+	defer teardown()
+
+	response := httpmock.NewBytesResponse(200, loadFixture("payouts.json"))
+	response.Header.Set("Link", `<http://valid.url?page_info=foo&limit=1>; rel="next"`)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/shopify_payments/payouts.json", client.pathPrefix),
+		httpmock.ResponderFromResponse(response))
+
+	payouts, pagination, err := client.ShopifyPayments.ListPayoutsWithPagination(nil)
+	if err != nil {
+		t.Errorf("ShopifyPayments.ListPayoutsWithPagination returned error: %v", err)
+	}
+
+	if len(payouts) != 1 {
+		t.Fatalf("ShopifyPayments.ListPayoutsWithPagination got %d payouts, expected 1", len(payouts))
+	}
+
+	expectedPagination := &Pagination{
+		NextPageOptions: &ListOptions{PageInfo: PString("foo"), Limit: PInt(1)},
+	}
+	if !reflect.DeepEqual(pagination, expectedPagination) {
+		t.Errorf("ShopifyPayments.ListPayoutsWithPagination returned pagination %+v, expected %+v", pagination, expectedPagination)
+	}
+}
+
+func TestShopifyPaymentsGetPayout(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/shopify_payments/payouts/623721858.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("payout.json")))
+
+	payout, err := client.ShopifyPayments.GetPayout(623721858)
+	if err != nil {
+		t.Errorf("ShopifyPayments.GetPayout returned error: %v", err)
+	}
+
+	payoutTests(t, *payout)
+}
+
+func TestShopifyPaymentsListDisputes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponderWithQuery("GET", fmt.Sprintf("https://"+testHost+"/%s/shopify_payments/disputes.json", client.pathPrefix),
+		map[string]string{"status": "needs_response"},
+		httpmock.NewBytesResponder(200, loadFixture("disputes.json")))
+
+	disputes, err := client.ShopifyPayments.ListDisputes(DisputeListOptions{Status: "needs_response"})
+	if err != nil {
+		t.Errorf("ShopifyPayments.ListDisputes returned error: %v", err)
+	}
+
+	if len(disputes) != 1 {
+		t.Fatalf("ShopifyPayments.ListDisputes got %d disputes, expected 1", len(disputes))
+	}
+	disputeTests(t, disputes[0])
+}
+
+func TestShopifyPaymentsListDisputesWithPagination(t *testing.T) {
+	setup()
+	defer teardown()
+
+	response := httpmock.NewBytesResponse(200, loadFixture("disputes.json"))
+	response.Header.Set("Link", `<http://valid.url?page_info=foo&limit=1>; rel="next"`)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/shopify_payments/disputes.json", client.pathPrefix),
+		httpmock.ResponderFromResponse(response))
+
+	disputes, pagination, err := client.ShopifyPayments.ListDisputesWithPagination(nil)
+	if err != nil {
+		t.Errorf("ShopifyPayments.ListDisputesWithPagination returned error: %v", err)
+	}
+
+	if len(disputes) != 1 {
+		t.Fatalf("ShopifyPayments.ListDisputesWithPagination got %d disputes, expected 1", len(disputes))
+	}
+
+	expectedPagination := &Pagination{
+		NextPageOptions: &ListOptions{PageInfo: PString("foo"), Limit: PInt(1)},
+	}
+	if !reflect.DeepEqual(pagination, expectedPagination) {
+		t.Errorf("ShopifyPayments.ListDisputesWithPagination returned pagination %+v, expected %+v", pagination, expectedPagination)
+	}
+}
+
+func TestShopifyPaymentsGetDispute(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/shopify_payments/disputes/598735659.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("dispute.json")))
+
+	dispute, err := client.ShopifyPayments.GetDispute(598735659)
+	if err != nil {
+		t.Errorf("ShopifyPayments.GetDispute returned error: %v", err)
+	}
+
+	disputeTests(t, *dispute)
+}
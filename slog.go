@@ -0,0 +1,91 @@
+//go:build go1.21
+
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+)
+
+// SlogLogger adapts a *slog.Logger to LeveledLoggerInterface so it can be
+// passed to WithLogger. Besides the printf style messages, every request is
+// logged as a structured record with the shop, method, url, status,
+// duration, attempts and request id as attributes. It requires Go 1.21 or
+// later, while the rest of the package supports Go 1.19.
+type SlogLogger struct {
+	Logger *slog.Logger
+}
+
+// NewSlogLogger returns a SlogLogger writing to logger, or to slog.Default()
+// if logger is nil.
+func NewSlogLogger(logger *slog.Logger) *SlogLogger {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &SlogLogger{Logger: logger}
+}
+
+// Debugf logs a debug message using Printf conventions.
+func (l *SlogLogger) Debugf(format string, v ...interface{}) {
+	l.logf(slog.LevelDebug, format, v...)
+}
+
+// Errorf logs an error message using Printf conventions.
+func (l *SlogLogger) Errorf(format string, v ...interface{}) {
+	l.logf(slog.LevelError, format, v...)
+}
+
+// Infof logs an informational message using Printf conventions.
+func (l *SlogLogger) Infof(format string, v ...interface{}) {
+	l.logf(slog.LevelInfo, format, v...)
+}
+
+// Warnf logs a warning message using Printf conventions.
+func (l *SlogLogger) Warnf(format string, v ...interface{}) {
+	l.logf(slog.LevelWarn, format, v...)
+}
+
+// DebugEnabled reports whether the logger emits debug messages.
+func (l *SlogLogger) DebugEnabled() bool {
+	return l.Logger.Enabled(context.Background(), slog.LevelDebug)
+}
+
+// LogRequest logs a completed request at debug level, or at warn level if
+// it failed.
+func (l *SlogLogger) LogRequest(entry RequestLog) {
+	level := slog.LevelDebug
+	if entry.Err != nil {
+		level = slog.LevelWarn
+	}
+
+	ctx := context.Background()
+	if !l.Logger.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("shop", entry.Shop),
+		slog.String("method", entry.Method),
+		slog.String("url", entry.URL),
+		slog.Int("status", entry.Status),
+		slog.Duration("duration", entry.Duration),
+		slog.Int("attempts", entry.Attempts),
+	}
+	if entry.RequestID != "" {
+		attrs = append(attrs, slog.String("request_id", entry.RequestID))
+	}
+	if entry.Err != nil {
+		attrs = append(attrs, slog.String("error", entry.Err.Error()))
+	}
+
+	l.Logger.LogAttrs(ctx, level, "shopify request", attrs...)
+}
+
+func (l *SlogLogger) logf(level slog.Level, format string, v ...interface{}) {
+	ctx := context.Background()
+	if !l.Logger.Enabled(ctx, level) {
+		return
+	}
+	l.Logger.Log(ctx, level, fmt.Sprintf(format, v...))
+}
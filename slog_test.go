@@ -0,0 +1,88 @@
+//go:build go1.21
+
+package goshopify
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func TestSlogLogger(t *testing.T) {
+	out := &bytes.Buffer{}
+	logger := NewSlogLogger(slog.New(slog.NewJSONHandler(out, &slog.HandlerOptions{Level: slog.LevelDebug})))
+
+	client = NewClient(app, testShopName, testToken, WithVersion(testApiVersion), WithLogger(logger))
+	httpmock.ActivateNonDefault(client.Client)
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", testUrl("/admin/api/9999-99/customers/1.json"),
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(http.StatusOK, `{"customer":{"id":1,"email":"bob@example.com"}}`)
+			resp.Header.Add("X-Request-Id", "a1b2c3")
+			return resp, nil
+		})
+
+	_, err := client.Customer.Get(1, nil)
+	if err != nil {
+		t.Fatalf("Customer.Get returned error: %v", err)
+	}
+
+	if strings.Contains(out.String(), "bob@example.com") {
+		t.Errorf("SlogLogger output contains unredacted email: %s", out.String())
+	}
+
+	var record map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		entry := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("SlogLogger wrote invalid JSON %s: %v", line, err)
+		}
+		if entry["msg"] == "shopify request" {
+			record = entry
+		}
+	}
+	if record == nil {
+		t.Fatalf("SlogLogger did not log the request: %s", out.String())
+	}
+
+	expected := map[string]interface{}{
+		"level":      "DEBUG",
+		"shop":       testHost,
+		"method":     "GET",
+		"url":        testUrl("/admin/api/9999-99/customers/1.json"),
+		"status":     float64(200),
+		"attempts":   float64(1),
+		"request_id": "a1b2c3",
+	}
+	for k, v := range expected {
+		if record[k] != v {
+			t.Errorf("SlogLogger attribute %s returned %v, expected %v", k, record[k], v)
+		}
+	}
+	if _, ok := record["duration"]; !ok {
+		t.Errorf("SlogLogger did not log the duration: %v", record)
+	}
+}
+
+func TestSlogLoggerLevels(t *testing.T) {
+	out := &bytes.Buffer{}
+	logger := NewSlogLogger(slog.New(slog.NewTextHandler(out, &slog.HandlerOptions{Level: slog.LevelWarn})))
+
+	logger.Debugf("debug %s", "log")
+	logger.Infof("info %s", "log")
+	logger.Warnf("warn %s", "log")
+	logger.Errorf("error %s", "log")
+
+	if strings.Contains(out.String(), "debug log") || strings.Contains(out.String(), "info log") {
+		t.Errorf("SlogLogger logged below the handler level: %s", out.String())
+	}
+	if !strings.Contains(out.String(), "level=WARN msg=\"warn log\"") || !strings.Contains(out.String(), "level=ERROR msg=\"error log\"") {
+		t.Errorf("SlogLogger did not log warnings and errors: %s", out.String())
+	}
+}
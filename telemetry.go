@@ -0,0 +1,217 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/bostin/go-shopify"
+
+const (
+	shopAttributeKey         = attribute.Key("shopify.shop")
+	pathTemplateAttributeKey = attribute.Key("shopify.path_template")
+	attemptsAttributeKey     = attribute.Key("shopify.attempts")
+	headroomAttributeKey     = attribute.Key("shopify.rate_limit.headroom")
+)
+
+var (
+	// numeric path segments, optionally followed by an extension, e.g. 450789469.json
+	idSegmentRegex = regexp.MustCompile(`^[0-9]+(\.[a-z]+)?$`)
+)
+
+// telemetry holds the OpenTelemetry tracer and instruments used to record
+// requests made by the client. A nil *telemetry records nothing.
+type telemetry struct {
+	tracer        trace.Tracer
+	duration      metric.Float64Histogram
+	throttled     metric.Int64Counter
+	serverErrors  metric.Int64Counter
+	retries       metric.Int64Counter
+	bytesSent     metric.Int64Counter
+	bytesReceived metric.Int64Counter
+}
+
+func newTelemetry(tp trace.TracerProvider, mp metric.MeterProvider) (*telemetry, error) {
+	if tp == nil {
+		tp = otel.GetTracerProvider()
+	}
+	if mp == nil {
+		mp = otel.GetMeterProvider()
+	}
+
+	meter := mp.Meter(instrumentationName)
+	t := &telemetry{tracer: tp.Tracer(instrumentationName)}
+
+	var err error
+	if t.duration, err = meter.Float64Histogram("shopify.client.duration",
+		metric.WithUnit("s"),
+		metric.WithDescription("Duration of Shopify API requests, including retries")); err != nil {
+		return nil, err
+	}
+	if t.throttled, err = meter.Int64Counter("shopify.client.throttled",
+		metric.WithDescription("Number of responses with status 429 Too Many Requests")); err != nil {
+		return nil, err
+	}
+	if t.serverErrors, err = meter.Int64Counter("shopify.client.server_errors",
+		metric.WithDescription("Number of responses with a 5xx status")); err != nil {
+		return nil, err
+	}
+	if t.retries, err = meter.Int64Counter("shopify.client.retries",
+		metric.WithDescription("Number of retried requests")); err != nil {
+		return nil, err
+	}
+	if t.bytesSent, err = meter.Int64Counter("shopify.client.request.size",
+		metric.WithUnit("By"),
+		metric.WithDescription("Size of request bodies sent to Shopify")); err != nil {
+		return nil, err
+	}
+	if t.bytesReceived, err = meter.Int64Counter("shopify.client.response.size",
+		metric.WithUnit("By"),
+		metric.WithDescription("Size of response bodies received from Shopify")); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// requestRecorder tracks a single call of Client.Do, across all its attempts.
+type requestRecorder struct {
+	t        *telemetry
+	ctx      context.Context
+	span     trace.Span
+	start    time.Time
+	attrs    []attribute.KeyValue
+	status   int
+	received *countingReader
+}
+
+// start opens a span for the request and returns the request carrying the
+// span context.
+func (t *telemetry) start(c *Client, req *http.Request) (*http.Request, *requestRecorder) {
+	if t == nil {
+		return req, nil
+	}
+
+	template := c.pathTemplate(req.URL.Path)
+	r := &requestRecorder{
+		t:     t,
+		start: time.Now(),
+		attrs: []attribute.KeyValue{
+			shopAttributeKey.String(c.baseURL.Host),
+			semconv.HTTPMethod(req.Method),
+			pathTemplateAttributeKey.String(template),
+		},
+	}
+
+	r.ctx, r.span = t.tracer.Start(req.Context(), fmt.Sprintf("%s %s", req.Method, template),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(r.attrs...),
+	)
+
+	if req.ContentLength > 0 {
+		t.bytesSent.Add(r.ctx, req.ContentLength, metric.WithAttributes(r.attrs...))
+	}
+
+	return req.WithContext(r.ctx), r
+}
+
+// attempt records the outcome of a single round trip.
+func (r *requestRecorder) attempt(resp *http.Response) {
+	if r == nil || resp == nil {
+		return
+	}
+
+	r.status = resp.StatusCode
+	attrs := metric.WithAttributes(r.attrs...)
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		r.t.throttled.Add(r.ctx, 1, attrs)
+	case resp.StatusCode >= http.StatusInternalServerError:
+		r.t.serverErrors.Add(r.ctx, 1, attrs)
+	}
+}
+
+// retry records that the request is about to be sent again.
+func (r *requestRecorder) retry() {
+	if r == nil {
+		return
+	}
+	r.t.retries.Add(r.ctx, 1, metric.WithAttributes(r.attrs...))
+}
+
+// countBody wraps a response body so the bytes read from it are recorded.
+func (r *requestRecorder) countBody(body io.ReadCloser) io.ReadCloser {
+	if r == nil {
+		return body
+	}
+	r.received = &countingReader{ReadCloser: body}
+	return r.received
+}
+
+// end closes the span and records the request duration.
+func (r *requestRecorder) end(c *Client, err error) {
+	if r == nil {
+		return
+	}
+
+	attrs := r.attrs
+	if r.status != 0 {
+		attrs = append(attrs, semconv.HTTPStatusCode(r.status))
+	}
+
+	r.t.duration.Record(r.ctx, time.Since(r.start).Seconds(), metric.WithAttributes(attrs...))
+	if r.received != nil && r.received.n > 0 {
+		r.t.bytesReceived.Add(r.ctx, r.received.n, metric.WithAttributes(attrs...))
+	}
+
+	r.span.SetAttributes(attrs...)
+	r.span.SetAttributes(attemptsAttributeKey.Int(c.attempts))
+	if c.RateLimits.BucketSize > 0 {
+		r.span.SetAttributes(headroomAttributeKey.Int(c.RateLimits.BucketSize - c.RateLimits.RequestCount))
+	}
+	if err != nil {
+		r.span.RecordError(err)
+		r.span.SetStatus(codes.Error, err.Error())
+	}
+	r.span.End()
+}
+
+// pathTemplate returns the request path relative to the api prefix with
+// numeric IDs replaced by a placeholder, e.g. orders/{id}.json, so it can be
+// used as a low cardinality span name and attribute.
+func (c *Client) pathTemplate(p string) string {
+	p = strings.TrimPrefix(p, "/")
+	p = strings.TrimPrefix(p, c.pathPrefix+"/")
+
+	segments := strings.Split(p, "/")
+	for i, segment := range segments {
+		if m := idSegmentRegex.FindStringSubmatch(segment); m != nil {
+			segments[i] = "{id}" + m[1]
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// countingReader counts the bytes read from the wrapped body.
+type countingReader struct {
+	io.ReadCloser
+	n int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,163 @@
+package goshopify
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func setupTelemetry() (*tracetest.SpanRecorder, sdkmetric.Reader) {
+	recorder := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+
+	client = NewClient(app, testShopName, testToken,
+		WithVersion(testApiVersion),
+		WithRetry(maxRetries),
+		WithTelemetry(
+			sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)),
+			sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)),
+		))
+	httpmock.ActivateNonDefault(client.Client)
+
+	return recorder, reader
+}
+
+func collectSums(t *testing.T, reader sdkmetric.Reader) map[string]int64 {
+	rm := metricdata.ResourceMetrics{}
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("Collect returned error: %v", err)
+	}
+
+	sums := map[string]int64{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			switch data := m.Data.(type) {
+			case metricdata.Sum[int64]:
+				for _, dp := range data.DataPoints {
+					sums[m.Name] += dp.Value
+				}
+			case metricdata.Histogram[float64]:
+				for _, dp := range data.DataPoints {
+					sums[m.Name] += int64(dp.Count)
+				}
+			}
+		}
+	}
+	return sums
+}
+
+func TestTelemetryRequest(t *testing.T) {
+	recorder, reader := setupTelemetry()
+	defer teardown()
+
+	attempt := 0
+	httpmock.RegisterResponder("GET", testUrl("/admin/api/9999-99/orders/450789469.json"),
+		func(req *http.Request) (*http.Response, error) {
+			attempt++
+			if attempt == 1 {
+				return httpmock.NewStringResponse(http.StatusServiceUnavailable, ""), nil
+			}
+			resp := httpmock.NewStringResponse(http.StatusOK, `{"order":{"id":450789469}}`)
+			resp.Header.Add("X-Shopify-Shop-Api-Call-Limit", "10/40")
+			return resp, nil
+		})
+
+	_, err := client.Order.Get(450789469, nil)
+	if err != nil {
+		t.Fatalf("Order.Get returned error: %v", err)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("expected 1 span, got %d", len(spans))
+	}
+
+	span := spans[0]
+	if span.Name() != "GET orders/{id}.json" {
+		t.Errorf("span name returned %s, expected %s", span.Name(), "GET orders/{id}.json")
+	}
+
+	attrs := attribute.NewSet(span.Attributes()...)
+	expected := []attribute.KeyValue{
+		attribute.String("shopify.shop", testHost),
+		attribute.String("http.method", "GET"),
+		attribute.String("shopify.path_template", "orders/{id}.json"),
+		attribute.Int("http.status_code", 200),
+		attribute.Int("shopify.attempts", 2),
+		attribute.Int("shopify.rate_limit.headroom", 30),
+	}
+	for _, kv := range expected {
+		if v, ok := attrs.Value(kv.Key); !ok || v != kv.Value {
+			t.Errorf("span attribute %s returned %v, expected %v", kv.Key, v.Emit(), kv.Value.Emit())
+		}
+	}
+
+	sums := collectSums(t, reader)
+	expectedSums := map[string]int64{
+		"shopify.client.duration":      1,
+		"shopify.client.server_errors": 1,
+		"shopify.client.retries":       1,
+		"shopify.client.response.size": int64(len(`{"order":{"id":450789469}}`)),
+	}
+	for name, value := range expectedSums {
+		if sums[name] != value {
+			t.Errorf("metric %s returned %d, expected %d", name, sums[name], value)
+		}
+	}
+}
+
+func TestTelemetryRequestError(t *testing.T) {
+	recorder, reader := setupTelemetry()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", testUrl("/admin/api/9999-99/orders/1.json"),
+		httpmock.NewStringResponder(http.StatusNotFound, `{"errors":"Not Found"}`))
+
+	_, err := client.Order.Get(1, nil)
+	if err == nil {
+		t.Fatal("Order.Get expected error, got nil")
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("expected 1 span, got %d", len(spans))
+	}
+	if spans[0].Status().Description != "Not Found" {
+		t.Errorf("span status returned %+v, expected Not Found", spans[0].Status())
+	}
+
+	sums := collectSums(t, reader)
+	if sums["shopify.client.retries"] != 0 {
+		t.Errorf("metric shopify.client.retries returned %d, expected 0", sums["shopify.client.retries"])
+	}
+}
+
+func TestPathTemplate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	cases := []struct {
+		path     string
+		expected string
+	}{
+		{"/admin/api/9999-99/orders.json", "orders.json"},
+		{"/admin/api/9999-99/orders/450789469.json", "orders/{id}.json"},
+		{"/admin/api/9999-99/orders/450789469/fulfillments/255858046/complete.json", "orders/{id}/fulfillments/{id}/complete.json"},
+		{"/admin/api/9999-99/products/count.json", "products/count.json"},
+		{"/other/1.json", "other/{id}.json"},
+	}
+
+	for _, c := range cases {
+		actual := client.pathTemplate(c.path)
+		if actual != c.expected {
+			t.Errorf("pathTemplate(%s) returned %s, expected %s", c.path, actual, c.expected)
+		}
+	}
+}
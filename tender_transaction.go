@@ -0,0 +1,182 @@
+package goshopify
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const tenderTransactionsBasePath = "tender_transactions"
+
+// TenderTransactionService is an interface for interfacing with the tender
+// transaction endpoint of the Shopify API.
+// See: https://shopify.dev/docs/api/admin-rest/2023-01/resources/tendertransaction
+type TenderTransactionService interface {
+	List(interface{}) ([]TenderTransaction, error)
+	ListWithPagination(interface{}) ([]TenderTransaction, *Pagination, error)
+}
+
+// TenderTransactionServiceOp handles communication with the tender
+// transaction related methods of the Shopify API.
+type TenderTransactionServiceOp struct {
+	client *Client
+}
+
+// TenderTransaction represents money passing between the merchant and a
+// customer, whatever the payment method. Refunds have a negative amount.
+type TenderTransaction struct {
+	ID              int64                            `json:"id,omitempty" bson:"id,omitempty"`
+	OrderID         int64                            `json:"order_id,omitempty" bson:"order_id,omitempty"`
+	Amount          *decimal.Decimal                 `json:"amount,omitempty" bson:"amount,omitempty"`
+	Currency        string                           `json:"currency,omitempty" bson:"currency,omitempty"`
+	UserID          int64                            `json:"user_id,omitempty" bson:"user_id,omitempty"`
+	Test            bool                             `json:"test,omitempty" bson:"test,omitempty"`
+	ProcessedAt     *time.Time                       `json:"processed_at,omitempty" bson:"processed_at,omitempty"`
+	RemoteReference string                           `json:"remote_reference,omitempty" bson:"remote_reference,omitempty"`
+	PaymentMethod   string                           `json:"payment_method,omitempty" bson:"payment_method,omitempty"`
+	PaymentDetails  *TenderTransactionPaymentDetails `json:"payment_details,omitempty" bson:"payment_details,omitempty"`
+}
+
+// TenderTransactionPaymentDetails represents the card used for a tender
+// transaction, if any
+type TenderTransactionPaymentDetails struct {
+	CreditCardNumber  string `json:"credit_card_number,omitempty" bson:"credit_card_number,omitempty"`
+	CreditCardCompany string `json:"credit_card_company,omitempty" bson:"credit_card_company,omitempty"`
+}
+
+// TenderTransactionListOptions represents the options available when listing
+// tender transactions. Order is either "processed_at ASC" or
+// "processed_at DESC", the default.
+type TenderTransactionListOptions struct {
+	ListOptions
+	ProcessedAt    *time.Time `url:"processed_at,omitempty"`
+	ProcessedAtMin *time.Time `url:"processed_at_min,omitempty"`
+	ProcessedAtMax *time.Time `url:"processed_at_max,omitempty"`
+}
+
+// TenderTransactionsResource represents the result from the tender_transactions.json endpoint
+type TenderTransactionsResource struct {
+	TenderTransactions []TenderTransaction `json:"tender_transactions" bson:"tender_transactions"`
+}
+
+// List tender transactions
+func (s *TenderTransactionServiceOp) List(options interface{}) ([]TenderTransaction, error) {
+	transactions, _, err := s.ListWithPagination(options)
+	if err != nil {
+		return nil, err
+	}
+	return transactions, nil
+}
+
+// ListWithPagination lists tender transactions and return pagination to retrieve next/previous results.
+func (s *TenderTransactionServiceOp) ListWithPagination(options interface{}) ([]TenderTransaction, *Pagination, error) {
+	path := fmt.Sprintf("%s.json", tenderTransactionsBasePath)
+	resource := new(TenderTransactionsResource)
+	headers := http.Header{}
+
+	headers, err := s.client.createAndDoGetHeaders("GET", path, nil, options, resource)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Extract pagination info from header
+	linkHeader := headers.Get("Link")
+
+	pagination, err := extractPagination(linkHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource.TenderTransactions, pagination, nil
+}
+
+// tenderTransactionCSVHeader is the first row written by
+// TenderTransactionCSVWriter
+var tenderTransactionCSVHeader = []string{
+	"id",
+	"order_id",
+	"processed_at",
+	"payment_method",
+	"amount",
+	"currency",
+	"remote_reference",
+	"credit_card_company",
+	"credit_card_number",
+	"user_id",
+	"test",
+}
+
+// TenderTransactionCSVWriter writes tender transactions as CSV, e.g. for
+// accounting exports. Amounts are written with the number of decimals of
+// their currency, e.g. two for USD and three for KWD, rounding half away from
+// zero the amounts which have more, so every amount of a currency has the
+// same format. Times are written in RFC 3339 format, in UTC.
+type TenderTransactionCSVWriter struct {
+	w             *csv.Writer
+	headerWritten bool
+}
+
+// NewTenderTransactionCSVWriter returns a TenderTransactionCSVWriter writing
+// to w.
+func NewTenderTransactionCSVWriter(w io.Writer) *TenderTransactionCSVWriter {
+	return &TenderTransactionCSVWriter{w: csv.NewWriter(w)}
+}
+
+// Write writes a row per tender transaction, preceded by a header row on the
+// first call. Call it with every page of tender transactions to export them
+// all.
+func (cw *TenderTransactionCSVWriter) Write(transactions []TenderTransaction) error {
+	if !cw.headerWritten {
+		if err := cw.w.Write(tenderTransactionCSVHeader); err != nil {
+			return err
+		}
+		cw.headerWritten = true
+	}
+
+	for _, transaction := range transactions {
+		if err := cw.w.Write(tenderTransactionCSVRecord(transaction)); err != nil {
+			return err
+		}
+	}
+
+	cw.w.Flush()
+	return cw.w.Error()
+}
+
+func tenderTransactionCSVRecord(transaction TenderTransaction) []string {
+	var processedAt, amount, userID string
+	if transaction.ProcessedAt != nil {
+		processedAt = transaction.ProcessedAt.UTC().Format(time.RFC3339)
+	}
+	if transaction.Amount != nil {
+		amount = transaction.Amount.StringFixed(currencyMinorUnits(transaction.Currency))
+	}
+	if transaction.UserID != 0 {
+		userID = strconv.FormatInt(transaction.UserID, 10)
+	}
+
+	var cardCompany, cardNumber string
+	if transaction.PaymentDetails != nil {
+		cardCompany = transaction.PaymentDetails.CreditCardCompany
+		cardNumber = transaction.PaymentDetails.CreditCardNumber
+	}
+
+	return []string{
+		strconv.FormatInt(transaction.ID, 10),
+		strconv.FormatInt(transaction.OrderID, 10),
+		processedAt,
+		transaction.PaymentMethod,
+		amount,
+		transaction.Currency,
+		transaction.RemoteReference,
+		cardCompany,
+		cardNumber,
+		userID,
+		strconv.FormatBool(transaction.Test),
+	}
+}
@@ -0,0 +1,134 @@
+package goshopify
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func TestTenderTransactionList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponderWithQuery("GET", fmt.Sprintf("https://"+testHost+"/%s/tender_transactions.json", client.pathPrefix),
+		map[string]string{"processed_at_min": "2023-01-01T00:00:00Z", "order": "processed_at ASC"},
+		httpmock.NewBytesResponder(200, loadFixture("tender_transactions.json")))
+
+	processedAtMin := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	options := TenderTransactionListOptions{
+		ListOptions:    ListOptions{Order: PString("processed_at ASC")},
+		ProcessedAtMin: &processedAtMin,
+	}
+	transactions, err := client.TenderTransaction.List(options)
+	if err != nil {
+		t.Errorf("TenderTransaction.List returned error: %v", err)
+	}
+
+	if len(transactions) != 2 {
+		t.Fatalf("TenderTransaction.List got %d tender transactions, expected 2", len(transactions))
+	}
+
+	transaction := transactions[0]
+	if transaction.ID != 1011222896 || transaction.OrderID != 450789469 || transaction.PaymentMethod != "credit_card" {
+		t.Errorf("TenderTransaction.List returned %+v, expected the credit card transaction of order 450789469", transaction)
+	}
+
+	expectedAmount := decimal.RequireFromString("250.5")
+	if transaction.Amount == nil || !transaction.Amount.Equal(expectedAmount) {
+		t.Errorf("TenderTransaction.Amount returned %v, expected %v", transaction.Amount, expectedAmount)
+	}
+
+	if transaction.PaymentDetails == nil || transaction.PaymentDetails.CreditCardCompany != "Bogus" {
+		t.Errorf("TenderTransaction.PaymentDetails returned %+v, expected a Bogus card", transaction.PaymentDetails)
+	}
+}
+
+func TestTenderTransactionListWithPagination(t *testing.T) {
+	setup()
+	defer teardown()
+
+	response := httpmock.NewBytesResponse(200, loadFixture("tender_transactions.json"))
+	response.Header.Set("Link", `<http://valid.url?page_info=foo&limit=2>; rel="next"`)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/tender_transactions.json", client.pathPrefix),
+		httpmock.ResponderFromResponse(response))
+
+	transactions, pagination, err := client.TenderTransaction.ListWithPagination(nil)
+	if err != nil {
+		t.Errorf("TenderTransaction.ListWithPagination returned error: %v", err)
+	}
+
+	if len(transactions) != 2 {
+		t.Fatalf("TenderTransaction.ListWithPagination got %d tender transactions, expected 2", len(transactions))
+	}
+
+	expectedPagination := &Pagination{
+		NextPageOptions: &ListOptions{PageInfo: PString("foo"), Limit: PInt(2)},
+	}
+	if !reflect.DeepEqual(pagination, expectedPagination) {
+		t.Errorf("TenderTransaction.ListWithPagination returned pagination %+v, expected %+v", pagination, expectedPagination)
+	}
+}
+
+func TestTenderTransactionCSVWriter(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/tender_transactions.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("tender_transactions.json")))
+
+	transactions, err := client.TenderTransaction.List(nil)
+	if err != nil {
+		t.Fatalf("TenderTransaction.List returned error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	w := NewTenderTransactionCSVWriter(&buf)
+	if err := w.Write(transactions[:1]); err != nil {
+		t.Fatalf("TenderTransactionCSVWriter.Write returned error: %v", err)
+	}
+	if err := w.Write(transactions[1:]); err != nil {
+		t.Fatalf("TenderTransactionCSVWriter.Write returned error: %v", err)
+	}
+
+	expected := "id,order_id,processed_at,payment_method,amount,currency,remote_reference,credit_card_company,credit_card_number,user_id,test\n" +
+		"1011222896,450789469,2023-01-03T17:59:38Z,credit_card,250.50,USD,1118366,Bogus,•••• •••• •••• 1,,false\n" +
+		"1011222897,450789469,2023-01-04T13:00:00Z,cash,-10.00,USD,1118367,,,548380009,true\n"
+	if buf.String() != expected {
+		t.Errorf("TenderTransactionCSVWriter wrote\n%s\nexpected\n%s", buf.String(), expected)
+	}
+
+	// amounts use the decimals of their currency, rounded when they have more
+	amounts := []struct {
+		amount   string
+		currency string
+	}{
+		{"12.345", "KWD"},
+		{"7.5", "KWD"},
+		{"1500", "JPY"},
+		{"10.005", "USD"},
+		{"-0.125", "USD"},
+	}
+	buf.Reset()
+	w = NewTenderTransactionCSVWriter(&buf)
+	for i, a := range amounts {
+		amount := decimal.RequireFromString(a.amount)
+		if err := w.Write([]TenderTransaction{{ID: int64(i + 1), Amount: &amount, Currency: a.currency}}); err != nil {
+			t.Fatalf("TenderTransactionCSVWriter.Write returned error: %v", err)
+		}
+	}
+
+	expected = "id,order_id,processed_at,payment_method,amount,currency,remote_reference,credit_card_company,credit_card_number,user_id,test\n" +
+		"1,0,,,12.345,KWD,,,,,false\n" +
+		"2,0,,,7.500,KWD,,,,,false\n" +
+		"3,0,,,1500,JPY,,,,,false\n" +
+		"4,0,,,10.01,USD,,,,,false\n" +
+		"5,0,,,-0.13,USD,,,,,false\n"
+	if buf.String() != expected {
+		t.Errorf("TenderTransactionCSVWriter wrote\n%s\nexpected\n%s", buf.String(), expected)
+	}
+}
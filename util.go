@@ -58,3 +58,18 @@ func PInt64(v int64) *int64 {
 func PInt(v int) *int {
 	return &v
 }
+
+// currencyMinorUnits returns the number of decimals of an ISO 4217 currency,
+// two for the currencies not listed
+func currencyMinorUnits(currency string) int32 {
+	switch strings.ToUpper(currency) {
+	case "BIF", "CLP", "DJF", "GNF", "ISK", "JPY", "KMF", "KRW", "PYG", "RWF",
+		"UGX", "UYI", "VND", "VUV", "XAF", "XOF", "XPF":
+		return 0
+	case "BHD", "IQD", "JOD", "KWD", "LYD", "OMR", "TND":
+		return 3
+	case "CLF", "UYW":
+		return 4
+	}
+	return 2
+}
@@ -2,6 +2,7 @@ package goshopify
 
 import (
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/shopspring/decimal"
@@ -9,12 +10,14 @@ import (
 
 const variantsBasePath = "variants"
 const variantsResourceName = "variants"
+const variantsMaxLimit = 250
 
 type VariantListOptions struct {
+	PageInfo              *string  `json:"page_info,omitempty" url:"page_info,omitempty"`
 	ProductId             *string  `json:"product_id,omitempty" url:"product_id,omitempty"`
 	Fields                *string  `json:"fields,omitempty" url:"fields,omitempty"`
 	Limit                 *int     `json:"limit,omitempty" url:"limit,omitempty"`
-	PresentmentCurrencies []string `json:"presentment_currencies,omitempty" url:"presentment_currencies,omitempty"`
+	PresentmentCurrencies []string `json:"presentment_currencies,omitempty" url:"presentment_currencies,omitempty,comma"`
 	SinceId               *int64   `json:"since_id,omitempty" url:"since_id,omitempty"`
 }
 
@@ -23,6 +26,10 @@ type VariantListOptions struct {
 // See https://help.shopify.com/api/reference/product_variant
 type VariantService interface {
 	List(int64, interface{}) ([]Variant, error)
+	ListWithPagination(int64, interface{}) ([]Variant, *Pagination, error)
+	ListAll(interface{}) ([]Variant, error)
+	ListAllWithPagination(interface{}) ([]Variant, *Pagination, error)
+	Iterate(VariantListOptions) *VariantIterator
 	Count(int64, interface{}) (int, error)
 	Get(int64, interface{}) (*Variant, error)
 	Create(int64, Variant) (*Variant, error)
@@ -41,34 +48,54 @@ type VariantServiceOp struct {
 
 // Variant represents a Shopify variant
 type Variant struct {
-	ID                   int64            `json:"id,omitempty" bson:"id,omitempty"`
-	ProductID            int64            `json:"product_id,omitempty" bson:"product_id,omitempty"`
-	Title                string           `json:"title,omitempty" bson:"title,omitempty"`
-	Sku                  string           `json:"sku,omitempty" bson:"sku,omitempty"`
-	Position             int              `json:"position,omitempty" bson:"position,omitempty"`
-	Grams                int              `json:"grams,omitempty" json:"grams,omitempty"`
-	InventoryPolicy      string           `json:"inventory_policy,omitempty" json:"inventory_policy,omitempty"`
-	Price                *decimal.Decimal `json:"price,omitempty" bson:"price,omitempty"`
-	CompareAtPrice       *decimal.Decimal `json:"compare_at_price,omitempty" bson:"compare_at_price,omitempty"`
-	FulfillmentService   string           `json:"fulfillment_service,omitempty" bson:"fulfillment_service,omitempty"`
-	InventoryManagement  string           `json:"inventory_management,omitempty" bson:"inventory_management,omitempty"`
-	InventoryItemId      int64            `json:"inventory_item_id,omitempty" bson:"inventory_item_id,omitempty"`
-	Option1              string           `json:"option1,omitempty" bson:"option1,omitempty"`
-	Option2              string           `json:"option2,omitempty" bson:"option2,omitempty"`
-	Option3              string           `json:"option3,omitempty" bson:"option3,omitempty"`
-	CreatedAt            *time.Time       `json:"created_at,omitempty" json:"created_at,omitempty"`
-	UpdatedAt            *time.Time       `json:"updated_at,omitempty" json:"updated_at,omitempty"`
-	Taxable              bool             `json:"taxable,omitempty" bson:"taxable,omitempty"`
-	TaxCode              string           `json:"tax_code,omitempty" bson:"tax_code,omitempty"`
-	Barcode              string           `json:"barcode,omitempty" bson:"barcode,omitempty"`
-	ImageID              int64            `json:"image_id,omitempty" bson:"image_id,omitempty"`
-	InventoryQuantity    int              `json:"inventory_quantity,omitempty" bson:"inventory_quantity,omitempty"`
-	Weight               *decimal.Decimal `json:"weight,omitempty" bson:"weight,omitempty"`
-	WeightUnit           string           `json:"weight_unit,omitempty" bson:"weight_unit,omitempty"`
-	OldInventoryQuantity int              `json:"old_inventory_quantity,omitempty" json:"old_inventory_quantity,omitempty"`
-	RequireShipping      bool             `json:"requires_shipping,omitempty" bson:"require_shipping,omitempty"`
-	AdminGraphqlAPIID    string           `json:"admin_graphql_api_id,omitempty" bson:"admin_graphql_api_id,omitempty"`
-	Metafields           []Metafield      `json:"metafields,omitempty" bson:"metafields,omitempty"`
+	ID                   int64                     `json:"id,omitempty" bson:"id,omitempty"`
+	ProductID            int64                     `json:"product_id,omitempty" bson:"product_id,omitempty"`
+	Title                string                    `json:"title,omitempty" bson:"title,omitempty"`
+	Sku                  string                    `json:"sku,omitempty" bson:"sku,omitempty"`
+	Position             int                       `json:"position,omitempty" bson:"position,omitempty"`
+	Grams                int                       `json:"grams,omitempty" json:"grams,omitempty"`
+	InventoryPolicy      string                    `json:"inventory_policy,omitempty" json:"inventory_policy,omitempty"`
+	Price                *decimal.Decimal          `json:"price,omitempty" bson:"price,omitempty"`
+	CompareAtPrice       *decimal.Decimal          `json:"compare_at_price,omitempty" bson:"compare_at_price,omitempty"`
+	FulfillmentService   string                    `json:"fulfillment_service,omitempty" bson:"fulfillment_service,omitempty"`
+	InventoryManagement  string                    `json:"inventory_management,omitempty" bson:"inventory_management,omitempty"`
+	InventoryItemId      int64                     `json:"inventory_item_id,omitempty" bson:"inventory_item_id,omitempty"`
+	Option1              string                    `json:"option1,omitempty" bson:"option1,omitempty"`
+	Option2              string                    `json:"option2,omitempty" bson:"option2,omitempty"`
+	Option3              string                    `json:"option3,omitempty" bson:"option3,omitempty"`
+	CreatedAt            *time.Time                `json:"created_at,omitempty" json:"created_at,omitempty"`
+	UpdatedAt            *time.Time                `json:"updated_at,omitempty" json:"updated_at,omitempty"`
+	Taxable              bool                      `json:"taxable,omitempty" bson:"taxable,omitempty"`
+	TaxCode              string                    `json:"tax_code,omitempty" bson:"tax_code,omitempty"`
+	Barcode              string                    `json:"barcode,omitempty" bson:"barcode,omitempty"`
+	ImageID              int64                     `json:"image_id,omitempty" bson:"image_id,omitempty"`
+	InventoryQuantity    int                       `json:"inventory_quantity,omitempty" bson:"inventory_quantity,omitempty"`
+	Weight               *decimal.Decimal          `json:"weight,omitempty" bson:"weight,omitempty"`
+	WeightUnit           string                    `json:"weight_unit,omitempty" bson:"weight_unit,omitempty"`
+	OldInventoryQuantity int                       `json:"old_inventory_quantity,omitempty" json:"old_inventory_quantity,omitempty"`
+	RequireShipping      bool                      `json:"requires_shipping,omitempty" bson:"require_shipping,omitempty"`
+	AdminGraphqlAPIID    string                    `json:"admin_graphql_api_id,omitempty" bson:"admin_graphql_api_id,omitempty"`
+	Metafields           []Metafield               `json:"metafields,omitempty" bson:"metafields,omitempty"`
+	PresentmentPrices    []VariantPresentmentPrice `json:"presentment_prices,omitempty" bson:"presentment_prices,omitempty"`
+}
+
+// VariantPresentmentPrice represents the price of a variant in one of the
+// presentment currencies of the shop
+type VariantPresentmentPrice struct {
+	Price          *AmountSetEntry `json:"price,omitempty" bson:"price,omitempty"`
+	CompareAtPrice *AmountSetEntry `json:"compare_at_price,omitempty" bson:"compare_at_price,omitempty"`
+}
+
+// PresentmentPrice returns the price of the variant in a presentment
+// currency. Presentment prices are only returned when listing variants with
+// VariantListOptions.PresentmentCurrencies.
+func (v Variant) PresentmentPrice(currency string) (VariantPresentmentPrice, bool) {
+	for _, price := range v.PresentmentPrices {
+		if price.Price != nil && price.Price.CurrencyCode == currency {
+			return price, true
+		}
+	}
+	return VariantPresentmentPrice{}, false
 }
 
 // VariantResource represents the result from the variants/X.json endpoint
@@ -83,10 +110,135 @@ type VariantsResource struct {
 
 // List variants
 func (s *VariantServiceOp) List(productID int64, options interface{}) ([]Variant, error) {
+	variants, _, err := s.ListWithPagination(productID, options)
+	if err != nil {
+		return nil, err
+	}
+	return variants, nil
+}
+
+// ListWithPagination lists the variants of a product and return pagination to retrieve next/previous results.
+func (s *VariantServiceOp) ListWithPagination(productID int64, options interface{}) ([]Variant, *Pagination, error) {
 	path := fmt.Sprintf("%s/%d/variants.json", productsBasePath, productID)
+	return s.listWithPagination(path, options)
+}
+
+// ListAll lists the variants of all the products of the shop. The
+// variants.json endpoint it uses is not part of the documented REST API, so
+// Shopify may change or remove it; list the variants per product otherwise.
+func (s *VariantServiceOp) ListAll(options interface{}) ([]Variant, error) {
+	variants, _, err := s.ListAllWithPagination(options)
+	if err != nil {
+		return nil, err
+	}
+	return variants, nil
+}
+
+// ListAllWithPagination lists the variants of all the products of the shop and return pagination to retrieve
+// next/previous results. Like ListAll it uses the undocumented variants.json endpoint.
+func (s *VariantServiceOp) ListAllWithPagination(options interface{}) ([]Variant, *Pagination, error) {
+	path := fmt.Sprintf("%s.json", variantsBasePath)
+	return s.listWithPagination(path, options)
+}
+
+func (s *VariantServiceOp) listWithPagination(path string, options interface{}) ([]Variant, *Pagination, error) {
 	resource := new(VariantsResource)
-	err := s.client.Get(path, resource, options)
-	return resource.Variants, err
+	headers := http.Header{}
+
+	headers, err := s.client.createAndDoGetHeaders("GET", path, nil, options, resource)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Extract pagination info from header
+	linkHeader := headers.Get("Link")
+
+	pagination, err := extractPagination(linkHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource.Variants, pagination, nil
+}
+
+// Iterate returns a VariantIterator over the variants of all the products
+// of the shop matching the given options. Like ListAll it uses the
+// undocumented variants.json endpoint.
+func (s *VariantServiceOp) Iterate(options VariantListOptions) *VariantIterator {
+	return &VariantIterator{service: s, options: options}
+}
+
+// VariantIterator retrieves the variants of the shop one page at a time, for
+// catalogs too large to list at once. Call Next until it returns false, then
+// check Err:
+//
+//	it := client.Variant.Iterate(goshopify.VariantListOptions{})
+//	for it.Next() {
+//		variant := it.Variant()
+//	}
+//	if err := it.Err(); err != nil {
+//		// ...
+//	}
+type VariantIterator struct {
+	service VariantService
+	options VariantListOptions
+
+	page    []Variant
+	variant Variant
+	started bool
+	err     error
+}
+
+// Next advances the iterator to the next variant, retrieving the next page
+// when needed. It returns false when there are no more variants or an error
+// occurred.
+func (it *VariantIterator) Next() bool {
+	for len(it.page) == 0 {
+		if it.err != nil || (it.started && it.options.PageInfo == nil) {
+			return false
+		}
+		it.fetch()
+	}
+
+	it.variant, it.page = it.page[0], it.page[1:]
+	return true
+}
+
+// Variant returns the current variant
+func (it *VariantIterator) Variant() Variant {
+	return it.variant
+}
+
+// Err returns the error which stopped the iteration, if any
+func (it *VariantIterator) Err() error {
+	return it.err
+}
+
+// fetch retrieves the next page. The pages after the first are requested
+// with their page info, keeping the limit, fields and presentment currencies
+// of the first.
+func (it *VariantIterator) fetch() {
+	if it.options.Limit == nil || *it.options.Limit <= 0 {
+		it.options.Limit = PInt(variantsMaxLimit)
+	}
+
+	page, pagination, err := it.service.ListAllWithPagination(it.options)
+	it.started = true
+	if err != nil {
+		it.err = err
+		return
+	}
+	it.page = page
+
+	it.options.PageInfo = nil
+	if pagination != nil && pagination.NextPageOptions != nil {
+		it.options = VariantListOptions{
+			PageInfo:              pagination.NextPageOptions.PageInfo,
+			Fields:                it.options.Fields,
+			Limit:                 it.options.Limit,
+			PresentmentCurrencies: it.options.PresentmentCurrencies,
+		}
+	}
 }
 
 // Count variants
@@ -457,3 +457,157 @@ func variantTestsWithTaxCode(t *testing.T, variant Variant) {
 	}
 
 }
+
+func TestVariantListQuery(t *testing.T) {
+	setup()
+	defer teardown()
+
+	// presentment currencies are sent comma separated, as Shopify expects
+	httpmock.RegisterResponderWithQuery("GET", fmt.Sprintf("https://"+testHost+"/%s/products/1/variants.json", client.pathPrefix),
+		"presentment_currencies=EUR%2CUSD&since_id=10&limit=5&fields=id%2Cprice",
+		httpmock.NewStringResponder(200, `{"variants": [{"id":11}]}`))
+
+	options := VariantListOptions{
+		PresentmentCurrencies: []string{"EUR", "USD"},
+		SinceId:               PInt64(10),
+		Limit:                 PInt(5),
+		Fields:                PString("id,price"),
+	}
+	variants, err := client.Variant.List(1, options)
+	if err != nil {
+		t.Errorf("Variant.List returned error: %v", err)
+	}
+
+	expected := []Variant{{ID: 11}}
+	if !reflect.DeepEqual(variants, expected) {
+		t.Errorf("Variant.List returned %+v, expected %+v", variants, expected)
+	}
+}
+
+func TestVariantListWithPagination(t *testing.T) {
+	setup()
+	defer teardown()
+
+	response := httpmock.NewStringResponse(200, `{"variants": [{"id":1}]}`)
+	response.Header.Set("Link", `<http://valid.url?page_info=foo&limit=1>; rel="next"`)
+	httpmock.RegisterResponderWithQuery("GET", fmt.Sprintf("https://"+testHost+"/%s/products/1/variants.json", client.pathPrefix),
+		map[string]string{"limit": "1"},
+		httpmock.ResponderFromResponse(response))
+
+	variants, pagination, err := client.Variant.ListWithPagination(1, VariantListOptions{Limit: PInt(1)})
+	if err != nil {
+		t.Errorf("Variant.ListWithPagination returned error: %v", err)
+	}
+
+	expected := []Variant{{ID: 1}}
+	if !reflect.DeepEqual(variants, expected) {
+		t.Errorf("Variant.ListWithPagination returned %+v, expected %+v", variants, expected)
+	}
+
+	expectedPagination := &Pagination{
+		NextPageOptions: &ListOptions{PageInfo: PString("foo"), Limit: PInt(1)},
+	}
+	if !reflect.DeepEqual(pagination, expectedPagination) {
+		t.Errorf("Variant.ListWithPagination returned pagination %+v, expected %+v", pagination, expectedPagination)
+	}
+}
+
+func TestVariantListAllWithPresentmentPrices(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponderWithQuery("GET", fmt.Sprintf("https://"+testHost+"/%s/variants.json", client.pathPrefix),
+		map[string]string{"presentment_currencies": "EUR,USD"},
+		httpmock.NewStringResponder(200, `{"variants": [{"id":1,"presentment_prices":[
+			{"price":{"amount":"19.99","currency_code":"EUR"},"compare_at_price":null},
+			{"price":{"amount":"21.00","currency_code":"USD"},"compare_at_price":{"amount":"25.00","currency_code":"USD"}}
+		]}]}`))
+
+	variants, err := client.Variant.ListAll(VariantListOptions{PresentmentCurrencies: []string{"EUR", "USD"}})
+	if err != nil {
+		t.Fatalf("Variant.ListAll returned error: %v", err)
+	}
+
+	if len(variants) != 1 {
+		t.Fatalf("Variant.ListAll returned %d variants, expected 1", len(variants))
+	}
+
+	price, ok := variants[0].PresentmentPrice("USD")
+	if !ok {
+		t.Fatal("Variant.PresentmentPrice returned no USD price")
+	}
+
+	expectedAmount := decimal.RequireFromString("21.00")
+	if !price.Price.Amount.Equal(expectedAmount) {
+		t.Errorf("Variant.PresentmentPrice returned price %v, expected %v", price.Price.Amount, expectedAmount)
+	}
+
+	expectedCompareAt := decimal.RequireFromString("25.00")
+	if price.CompareAtPrice == nil || !price.CompareAtPrice.Amount.Equal(expectedCompareAt) {
+		t.Errorf("Variant.PresentmentPrice returned compare at price %+v, expected %v", price.CompareAtPrice, expectedCompareAt)
+	}
+
+	price, ok = variants[0].PresentmentPrice("EUR")
+	if !ok || price.CompareAtPrice != nil {
+		t.Errorf("Variant.PresentmentPrice returned %+v, %v for EUR, expected a price without compare at price", price, ok)
+	}
+
+	if _, ok := variants[0].PresentmentPrice("GBP"); ok {
+		t.Error("Variant.PresentmentPrice returned a GBP price, expected none")
+	}
+}
+
+func TestVariantIterate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	variantsURL := fmt.Sprintf("https://"+testHost+"/%s/variants.json", client.pathPrefix)
+
+	first := httpmock.NewStringResponse(200, `{"variants": [{"id":1},{"id":2}]}`)
+	first.Header.Set("Link", `<http://valid.url?page_info=page2&limit=2>; rel="next"`)
+	httpmock.RegisterResponderWithQuery("GET", variantsURL,
+		map[string]string{"limit": "2", "fields": "id", "product_id": "7"},
+		httpmock.ResponderFromResponse(first))
+
+	second := httpmock.NewStringResponse(200, `{"variants": [{"id":3}]}`)
+	second.Header.Set("Link", `<http://valid.url?page_info=page1&limit=2>; rel="previous"`)
+	httpmock.RegisterResponderWithQuery("GET", variantsURL,
+		map[string]string{"limit": "2", "fields": "id", "page_info": "page2"},
+		httpmock.ResponderFromResponse(second))
+
+	it := client.Variant.Iterate(VariantListOptions{Limit: PInt(2), Fields: PString("id"), ProductId: PString("7")})
+
+	var ids []int64
+	for it.Next() {
+		ids = append(ids, it.Variant().ID)
+	}
+	if err := it.Err(); err != nil {
+		t.Errorf("VariantIterator.Err returned %v", err)
+	}
+
+	expected := []int64{1, 2, 3}
+	if !reflect.DeepEqual(ids, expected) {
+		t.Errorf("VariantIterator returned %v, expected %v", ids, expected)
+	}
+
+	if it.Next() {
+		t.Error("VariantIterator.Next returned true after the last variant")
+	}
+}
+
+func TestVariantIterateError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://"+testHost+"/%s/variants.json", client.pathPrefix),
+		httpmock.NewStringResponder(500, `{"errors": "boom"}`))
+
+	it := client.Variant.Iterate(VariantListOptions{})
+	if it.Next() {
+		t.Error("VariantIterator.Next returned true, expected false")
+	}
+
+	if it.Err() == nil {
+		t.Error("VariantIterator.Err expected an error")
+	}
+}